@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+data "adyen_android_apps" "pos" {
+  company_id   = "WeaveAccount"
+  package_name = "com.weave.pos"
+  status       = "ready"
+}
+
+output "latest_pos_app_id" {
+  value = one([for app in data.adyen_android_apps.pos.apps : app.id if app.version_code == max(data.adyen_android_apps.pos.apps[*].version_code...)])
+}
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+data "adyen_android_certificates" "root" {
+  company_id = "WeaveAccount"
+  name       = "weave-root"
+  status     = "ready"
+}
+
+output "root_certificate_ids" {
+  value = data.adyen_android_certificates.root.ids
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+data "adyen_api_credential_me" "current" {}
+
+check "api_credential_roles" {
+  assert {
+    condition     = contains(data.adyen_api_credential_me.current.roles, "Management API - Webhooks read and write")
+    error_message = "The API credential of the provider cannot manage webhooks."
+  }
+}
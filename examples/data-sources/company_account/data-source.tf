@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+data "adyen_company_account" "example" {
+  id = "WeaveAccount"
+}
+
+output "merchant_account_ids" {
+  value = data.adyen_company_account.example.merchant_accounts[*].id
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+data "adyen_company_accounts" "all" {}
+
+output "company_merchant_accounts" {
+  value = {
+    for company in data.adyen_company_accounts.all.company_accounts :
+    company.id => company.merchant_accounts[*].id
+  }
+}
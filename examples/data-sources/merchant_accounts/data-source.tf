@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+data "adyen_merchant_accounts" "example" {
+  company_id = "WeaveAccount"
+}
+
+output "active_merchant_accounts" {
+  value = [for merchant in data.adyen_merchant_accounts.example.merchant_accounts : merchant.id if merchant.status == "Active"]
+}
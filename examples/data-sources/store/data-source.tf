@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+data "adyen_store" "example_store" {
+  merchant_id = "WeaveAccountECOM"
+  reference   = "AmsterdamCentral"
+}
+
+output "store_id" {
+  value = data.adyen_store.example_store.id
+}
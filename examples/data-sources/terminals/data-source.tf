@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+# The terminals assigned to the stores of a merchant account in the Netherlands.
+data "adyen_terminals" "example_dutch_stores" {
+  countries    = ["NL"]
+  merchant_ids = ["WeaveAccountPOS"]
+}
+
+# The terminals with an ID containing the search query. Cannot be combined with the other filters.
+data "adyen_terminals" "example_search" {
+  search_query = "V400m-0800209"
+}
+
+output "terminal_stores" {
+  value = { for terminal in data.adyen_terminals.example_dutch_stores.terminals : terminal.id => terminal.store_id }
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+data "adyen_webhooks_company" "example_webhook" {
+  company_account = "WeaveAccount"
+  id              = "WBHK00000000000000000000"
+}
+
+data "adyen_webhooks_company_list" "all" {
+  company_account = "WeaveAccount"
+}
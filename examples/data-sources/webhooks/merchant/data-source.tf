@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+data "adyen_webhooks_merchant" "example_webhook" {
+  id = "WBHK00000000000000000000"
+}
+
+data "adyen_webhooks_merchant_list" "all" {}
+
+# Webhooks of a merchant account other than the one of the provider
+data "adyen_webhooks_merchant_list" "pos" {
+  merchant_account = "WeaveAccountPOS"
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+data "adyen_webhook_test" "example_webhook_test" {
+  webhook_id = "WBHK00000000000000000000"
+  types      = ["AUTHORISATION"]
+}
+
+# Fail the run when the webhook does not accept the test notifications.
+check "webhook_reachable" {
+  assert {
+    condition     = data.adyen_webhook_test.example_webhook_test.success
+    error_message = "The webhook did not accept the test notifications."
+  }
+}
@@ -0,0 +1,5 @@
+# Allowed origins of a merchant API credential can be imported using "<merchant_account>/<api_credential_id>/<origin_id>".
+terraform import adyen_allowed_origin.example_origin WeaveAccountECOM/S2-00000000000000000000/AO0000000000000000000000
+
+# Allowed origins of a company API credential can be imported using "company/<company_account>/<api_credential_id>/<origin_id>".
+terraform import adyen_allowed_origin.example_origin company/WeaveAccount/S2-00000000000000000000/AO0000000000000000000000
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+resource "adyen_api_credential_merchant" "example_credential" {
+  description = "Example merchant API credential"
+}
+
+resource "adyen_allowed_origin" "example_origin" {
+  api_credential_id = adyen_api_credential_merchant.example_credential.id
+  domain            = "https://www.example.com"
+}
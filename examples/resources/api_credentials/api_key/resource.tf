@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+resource "adyen_api_credential_company" "example_credential" {
+  company_account = "WeaveAccount"
+  description     = "Example company API credential"
+}
+
+# Change rotation_trigger to generate a new API key, which invalidates the previous one.
+resource "adyen_api_credential_api_key" "example_api_key" {
+  api_credential_id = adyen_api_credential_company.example_credential.id
+  company_account   = adyen_api_credential_company.example_credential.company_account
+  rotation_trigger = {
+    rotated_at = "2024-01-01"
+  }
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+resource "adyen_api_credential_merchant" "example_credential" {
+  description     = "Example merchant API credential"
+  allowed_origins = ["https://www.example.com"]
+}
+
+# Change rotation_trigger to generate a new client key, which invalidates the previous one.
+resource "adyen_api_credential_client_key" "example_client_key" {
+  api_credential_id = adyen_api_credential_merchant.example_credential.id
+  rotation_trigger = {
+    rotated_at = "2024-01-01"
+  }
+}
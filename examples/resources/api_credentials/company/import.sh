@@ -0,0 +1,3 @@
+# API credentials can be imported using "<company_account>/<api_credential_id>".
+# Adyen only returns the API key and password on creation, so they are empty after an import.
+terraform import adyen_api_credential_company.example_credential WeaveAccount/S2-00000000000000000000
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+resource "adyen_api_credential_company" "example_credential" {
+  company_account              = "WeaveAccount"
+  description                  = "Example company API credential"
+  roles                        = ["Checkout webservice role", "Management API - Accounts read"]
+  allowed_origins              = ["https://www.example.com"]
+  associated_merchant_accounts = ["WeaveAccountECOM"]
+}
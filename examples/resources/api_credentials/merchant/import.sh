@@ -0,0 +1,3 @@
+# API credentials can be imported using "<merchant_account>/<api_credential_id>".
+# Adyen only returns the API key and password on creation, so they are empty after an import.
+terraform import adyen_api_credential_merchant.example_credential WeaveAccountECOM/S2-00000000000000000000
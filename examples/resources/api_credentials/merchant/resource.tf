@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+resource "adyen_api_credential_merchant" "example_credential" {
+  description     = "Example merchant API credential"
+  roles           = ["Checkout webservice role"]
+  allowed_origins = ["https://www.example.com"]
+}
@@ -0,0 +1,2 @@
+# Merchant accounts can be imported using their identifier.
+terraform import adyen_merchant_account.example_merchant_account WeaveAccountExample
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+resource "adyen_merchant_account" "example_merchant_account" {
+  company_id  = "WeaveAccount"
+  description = "Example merchant account"
+  reference   = "WeaveAccountExample"
+  activate    = true
+}
@@ -0,0 +1,2 @@
+# Payment methods can be imported using the merchant account and the payment method identifier.
+terraform import adyen_payment_method.example_visa WeaveAccountECOM/PM00000000000000000000000
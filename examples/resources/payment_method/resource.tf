@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+resource "adyen_payment_method" "example_visa" {
+  type       = "visa"
+  countries  = ["NL", "BE"]
+  currencies = ["EUR"]
+}
+
+resource "adyen_payment_method" "example_paypal" {
+  type       = "paypal"
+  currencies = ["EUR"]
+  paypal = {
+    payer_id       = "ABCDEFGHIJKLM"
+    subject        = "payments@example.com"
+    direct_capture = true
+  }
+}
+
+resource "adyen_payment_method" "example_google_pay" {
+  type = "googlepay"
+  google_pay = {
+    merchant_id = "BCR2DN4T12345678"
+  }
+}
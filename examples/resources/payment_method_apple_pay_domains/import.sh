@@ -0,0 +1,2 @@
+# Apple Pay domains can be imported using the merchant account and the payment method identifier.
+terraform import adyen_payment_method_apple_pay_domains.example_apple_pay_domains WeaveAccountECOM/PM00000000000000000000000
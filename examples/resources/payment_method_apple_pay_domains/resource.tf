@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+resource "adyen_payment_method" "example_apple_pay" {
+  type = "applepay"
+  apple_pay = {
+    domains = ["www.example.com"]
+  }
+}
+
+# www.example.com is registered through the apple_pay block, so only the additional domains are listed here.
+resource "adyen_payment_method_apple_pay_domains" "example_apple_pay_domains" {
+  payment_method_id = adyen_payment_method.example_apple_pay.id
+  domains = [
+    "shop.example.com",
+    "pay.example.com",
+  ]
+}
@@ -0,0 +1,2 @@
+# Payout settings can be imported using the merchant account and the payout setting identifier.
+terraform import adyen_payout_setting.example_payout_setting WeaveAccountECOM/PYST00000000000000000000
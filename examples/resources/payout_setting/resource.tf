@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+resource "adyen_payout_setting" "example_payout_setting" {
+  transfer_instrument_id = "SE00000000000000000000000"
+  enabled                = true
+}
@@ -0,0 +1,2 @@
+# Stores can be imported using their identifier.
+terraform import adyen_store.example_store ST00000000000000000000000
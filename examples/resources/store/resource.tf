@@ -0,0 +1,45 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+# Store of the merchant account of the provider.
+resource "adyen_store" "example_store" {
+  reference         = "AmsterdamCentral"
+  description       = "Amsterdam Central"
+  shopper_statement = "Weave Amsterdam"
+  phone_number      = "+31201234567"
+  address = {
+    country     = "NL"
+    line1       = "Simon Carmiggeltstraat 6-50"
+    city        = "Amsterdam"
+    postal_code = "1011 DJ"
+  }
+}
+
+# Store of another merchant account of the company, created through the company-level endpoint.
+resource "adyen_store" "example_pos_store" {
+  merchant_id       = "WeaveAccountPOS"
+  reference         = "RotterdamPOS"
+  description       = "Rotterdam POS"
+  shopper_statement = "Weave Rotterdam"
+  phone_number      = "+31101234567"
+  status            = "inactive"
+  address = {
+    country     = "NL"
+    line1       = "Coolsingel 40"
+    city        = "Rotterdam"
+    postal_code = "3011 AD"
+  }
+}
@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+# Install an Android app on two terminals after the given time.
+resource "adyen_terminal_action" "example_install_app" {
+  company_id   = "WeaveAccount"
+  type         = "InstallAndroidApp"
+  app_id       = "ANDA422LZ223223K5F694GCCF732K8"
+  terminal_ids = ["V400m-080020970", "V400m-080020971"]
+  scheduled_at = "2024-11-15T12:16:21+01:00"
+}
+
+# Update the software release of a terminal at its next maintenance call.
+resource "adyen_terminal_action" "example_release_update" {
+  company_id                       = "WeaveAccount"
+  type                             = "ReleaseUpdate"
+  update_at_first_maintenance_call = true
+  terminal_ids                     = ["S1F2-000158212345678"]
+}
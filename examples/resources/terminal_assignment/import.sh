@@ -0,0 +1,2 @@
+# The assignment of a terminal can be imported using the terminal identifier.
+terraform import adyen_terminal_assignment.example_store V400m-080020970
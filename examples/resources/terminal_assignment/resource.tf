@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+# Reassign a terminal to a store.
+resource "adyen_terminal_assignment" "example_store" {
+  terminal_id = "V400m-080020970"
+  store_id    = "ST3224Z223225T5JQTRDD7CRZ"
+}
+
+# Reassign a terminal to the inventory of a merchant account.
+resource "adyen_terminal_assignment" "example_merchant_inventory" {
+  terminal_id = "V400m-080020971"
+  merchant_id = "WeaveAccountPOS"
+}
+
+# Reassign a terminal to the inventory of the company account.
+resource "adyen_terminal_assignment" "example_company_inventory" {
+  terminal_id = "S1F2-000158212345678"
+  company_id  = "WeaveAccount"
+}
@@ -0,0 +1,4 @@
+# Terminal logos can be imported using the level (company, merchant or store), its identifier and the terminal model,
+# or using terminal and the terminal identifier for a single terminal.
+terraform import adyen_terminal_logo.example_merchant_terminal_logo merchant/WeaveAccountECOM/V400m
+terraform import adyen_terminal_logo.example_terminal_logo terminal/V400m-080020970
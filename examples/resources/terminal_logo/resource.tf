@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+# The logo of all V400m terminals of the merchant account, updated whenever the local file changes.
+resource "adyen_terminal_logo" "example_merchant_terminal_logo" {
+  merchant_id = "WeaveAccountECOM"
+  model       = "V400m"
+  file        = "${path.module}/logo.png"
+}
+
+# A single terminal has only one model, so the model is not set.
+resource "adyen_terminal_logo" "example_terminal_logo" {
+  terminal_id = "V400m-080020970"
+  data        = filebase64("${path.module}/store-logo.png")
+}
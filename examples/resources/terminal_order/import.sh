@@ -0,0 +1,4 @@
+# Terminal orders of a merchant account can be imported using the merchant account and the order identifier.
+terraform import adyen_terminal_order.example_terminal_order WeaveAccountECOM/4154567890100682
+# Terminal orders of a company account can be imported using company, the company account and the order identifier.
+terraform import adyen_terminal_order.example_company_terminal_order company/WeaveAccount/4154567890100683
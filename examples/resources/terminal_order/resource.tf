@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+# An order for the merchant account of the provider.
+resource "adyen_terminal_order" "example_terminal_order" {
+  billing_entity_id        = "MerchantAccount.WeaveAccountECOM"
+  shipping_location_id     = "S2-232A6D2B7C3D"
+  customer_order_reference = "PO-2024-001"
+  items = [
+    {
+      id       = "TBOX-V400m-684-EU"
+      quantity = 2
+    },
+  ]
+}
+
+# An order for a company account.
+resource "adyen_terminal_order" "example_company_terminal_order" {
+  company_id           = "WeaveAccount"
+  billing_entity_id    = "Company.WeaveAccount"
+  shipping_location_id = "S2-6A6C2E3432747D4F2F2C3455485E3836457D"
+  items = [
+    {
+      id       = "TBOX-S1F2-EU"
+      quantity = 1
+    },
+  ]
+}
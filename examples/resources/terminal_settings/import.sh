@@ -0,0 +1,3 @@
+# Terminal settings can be imported using the level (company, merchant, store or terminal) and its identifier.
+# All settings of the level are imported, including the ones it inherits.
+terraform import adyen_terminal_settings.example_merchant_terminal_settings merchant/WeaveAccountECOM
@@ -0,0 +1,62 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+
+# Only the configured settings are managed, the others keep what they inherit from the company account.
+resource "adyen_terminal_settings" "example_merchant_terminal_settings" {
+  merchant_id = "WeaveAccountECOM"
+
+  receipt_options = {
+    prompt_before_printing = true
+  }
+
+  gratuities = [
+    {
+      currency                   = "EUR"
+      use_predefined_tip_entries = true
+      predefined_tip_entries     = ["5%", "10%", "15%"]
+    },
+  ]
+
+  wifi_profiles = {
+    profiles = [
+      {
+        name      = "Shop"
+        ssid      = "Shop"
+        auth_type = "wpa2-psk"
+        bss_type  = "infra"
+        wsec      = "ccmp"
+        psk       = "change-me"
+      },
+    ]
+  }
+
+  passcodes = {
+    admin_menu_pin = "1234"
+  }
+}
+
+resource "adyen_terminal_settings" "example_store_terminal_settings" {
+  store_id = "ST00000000000000000000000"
+
+  timeouts = {
+    from_active_to_sleep = 60
+  }
+
+  standalone = {
+    enable_standalone = true
+    currency_code     = "EUR"
+  }
+}
@@ -0,0 +1,2 @@
+# Users can be imported using "<company_account>/<user_id>".
+terraform import adyen_user_company.example_user WeaveAccount/S2-00000000000000000000
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+resource "adyen_user_company" "example_user" {
+  company_account = "WeaveAccount"
+  username        = "example_company_user"
+  email           = "example.company@example.com"
+  name = {
+    first_name = "Example"
+    last_name  = "User"
+  }
+  roles                        = ["Merchant admin"]
+  associated_merchant_accounts = ["WeaveAccountECOM"]
+}
@@ -0,0 +1,2 @@
+# Users can be imported using "<merchant_account>/<user_id>". The merchant account must match the one configured for the provider.
+terraform import adyen_user_merchant.example_user WeaveAccountECOM/S2-00000000000000000000
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+resource "adyen_user_merchant" "example_user" {
+  username = "example_user"
+  email    = "example.user@example.com"
+  name = {
+    first_name = "Example"
+    last_name  = "User"
+  }
+  time_zone_code = "Europe/Amsterdam"
+  roles          = ["Merchant admin"]
+}
@@ -0,0 +1,2 @@
+# Webhooks can be imported using "<company_account>/<webhook_id>".
+terraform import adyen_webhooks_company.example_webhook WeaveAccount/WBHK00000000000000000000
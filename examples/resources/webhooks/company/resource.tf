@@ -13,8 +13,12 @@ provider "adyen" {
   merchant_account = "WeaveAccountECOM"
 }
 
+data "adyen_company_account" "weave" {
+  id = "WeaveAccount"
+}
+
 resource "adyen_webhooks_company" "example_webhook" {
-  company_account = "WeaveAccount"
+  company_account = data.adyen_company_account.weave.id
   webhooks_company = {
     type                               = "standard"
     url                                = "https://webhook.site/cb798fb3-7092-4cab-986b-f416fb04f92e"
@@ -27,6 +31,6 @@ resource "adyen_webhooks_company" "example_webhook" {
     accepts_untrusted_root_certificate = true
     populate_soap_action_header        = false
     filter_merchant_account_type       = "includeAccounts"
-    filter_merchant_accounts           = ["WeaveAccountECOM"]
+    filter_merchant_accounts           = [for merchant in data.adyen_company_account.weave.merchant_accounts : merchant.id if merchant.status == "Active"]
   }
 }
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    adyen = {
+      version = ">= 0.0.1"
+      source  = "weavedev/adyen"
+    }
+  }
+}
+
+provider "adyen" {
+  api_key          = "YOUR_API_KEY"
+  environment      = "test"
+  merchant_account = "WeaveAccountECOM"
+}
+
+resource "adyen_webhooks_merchant" "example_webhook" {
+  webhooks_merchant = {
+    type                 = "standard"
+    url                  = "https://webhook.site/cb798fb3-7092-4cab-986b-f416fb04f92e"
+    active               = true
+    communication_format = "json"
+  }
+}
+
+# Change rotation_trigger to generate a new HMAC key, which invalidates the previous one.
+resource "adyen_webhook_hmac_key" "example_hmac_key" {
+  webhook_id = adyen_webhooks_merchant.example_webhook.webhooks_merchant.id
+  rotation_trigger = {
+    rotated_at = "2024-01-01"
+  }
+}
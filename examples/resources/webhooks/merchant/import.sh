@@ -0,0 +1,2 @@
+# Webhooks can be imported using "<merchant_account>/<webhook_id>". The merchant account must match the one configured for the provider.
+terraform import adyen_webhooks_merchant.example_webhook WeaveAccountECOM/WBHK00000000000000000000
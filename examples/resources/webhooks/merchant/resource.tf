@@ -25,5 +25,11 @@ resource "adyen_webhooks_merchant" "example_webhook" {
     accepts_self_signed_certificate    = true
     accepts_untrusted_root_certificate = true
     populate_soap_action_header        = false
+    additional_settings = {
+      include_event_codes = ["AUTHORISATION"]
+      properties = {
+        captureDelayHours = true
+      }
+    }
   }
 }
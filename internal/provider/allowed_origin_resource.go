@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"strings"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &allowedOriginResource{}
+	_ resource.ResourceWithConfigure   = &allowedOriginResource{}
+	_ resource.ResourceWithImportState = &allowedOriginResource{}
+)
+
+// allowedOriginResource is the resource implementation.
+type allowedOriginResource struct {
+	client *adyen.APIClient
+}
+
+// NewAllowedOriginResource is a helper function to simplify the provider implementation.
+func NewAllowedOriginResource() resource.Resource {
+	return &allowedOriginResource{}
+}
+
+// allowedOriginResourceModel maps the "allowed_origin" schema data for a resource.
+type allowedOriginResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ApiCredentialID types.String `tfsdk:"api_credential_id"`
+	CompanyAccount  types.String `tfsdk:"company_account"`
+	Domain          types.String `tfsdk:"domain"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *allowedOriginResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *allowedOriginResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allowed_origin"
+}
+
+// Schema defines the schema for the resource.
+func (r *allowedOriginResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an allowed origin of a merchant or company API credential. Allowed origins are the domains from which client-side requests with the client key are accepted.\n\n" +
+			"Do not combine this resource with the allowed_origins attribute of the API credential resources, as they would overwrite each other.\n\n" +
+			"Import an allowed origin using <merchant_account>/<api_credential_id>/<origin_id> for a merchant API credential, or company/<company_account>/<api_credential_id>/<origin_id> for a company API credential.\n\n" +
+			"To make this request, your API credential must have the following roles:\n\nManagement API—API credentials read and write",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the allowed origin.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_credential_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the API credential the allowed origin belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"company_account": schema.StringAttribute{
+				Optional:    true,
+				Description: "The company account of a company API credential. If not set, the API credential belongs to the merchant account of the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required:    true,
+				Description: "Domain of the allowed origin, for example https://www.example.com.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *allowedOriginResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen allowed origin")
+
+	// Retrieve values from the plan
+	var plan allowedOriginResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	allowedOriginRequest := management.AllowedOrigin{
+		Domain: plan.Domain.ValueString(),
+	}
+
+	var allowedOrigin management.AllowedOrigin
+	var err error
+	if plan.CompanyAccount.ValueString() != "" {
+		createAllowedOriginInput := r.client.Management().AllowedOriginsCompanyLevelApi.
+			CreateAllowedOriginInput(plan.CompanyAccount.ValueString(), plan.ApiCredentialID.ValueString()).
+			AllowedOrigin(allowedOriginRequest)
+		allowedOrigin, _, err = r.client.Management().AllowedOriginsCompanyLevelApi.CreateAllowedOrigin(ctx, createAllowedOriginInput)
+	} else {
+		createAllowedOriginInput := r.client.Management().AllowedOriginsMerchantLevelApi.
+			CreateAllowedOriginInput(r.client.GetConfig().MerchantAccount, plan.ApiCredentialID.ValueString()).
+			AllowedOrigin(allowedOriginRequest)
+		allowedOrigin, _, err = r.client.Management().AllowedOriginsMerchantLevelApi.CreateAllowedOrigin(ctx, createAllowedOriginInput)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating allowed origin",
+			"Could not create allowed origin "+plan.Domain.ValueString()+" for API credential "+plan.ApiCredentialID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringPointerValue(allowedOrigin.Id)
+	plan.Domain = types.StringValue(allowedOrigin.Domain)
+
+	// Set state with the fully populated allowed origin
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state by looking up the allowed origin in the allowed origins of its API credential.
+func (r *allowedOriginResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state allowedOriginResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var allowedOriginsResponse management.AllowedOriginsResponse
+	var httpRes *http.Response
+	var err error
+	if state.CompanyAccount.ValueString() != "" {
+		listAllowedOriginsInput := r.client.Management().AllowedOriginsCompanyLevelApi.ListAllowedOriginsInput(state.CompanyAccount.ValueString(), state.ApiCredentialID.ValueString())
+		allowedOriginsResponse, httpRes, err = r.client.Management().AllowedOriginsCompanyLevelApi.ListAllowedOrigins(ctx, listAllowedOriginsInput)
+	} else {
+		listAllowedOriginsInput := r.client.Management().AllowedOriginsMerchantLevelApi.ListAllowedOriginsInput(r.client.GetConfig().MerchantAccount, state.ApiCredentialID.ValueString())
+		allowedOriginsResponse, httpRes, err = r.client.Management().AllowedOriginsMerchantLevelApi.ListAllowedOrigins(ctx, listAllowedOriginsInput)
+	}
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the API credential does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Allowed Origin",
+			"Could not read allowed origins of API credential "+state.ApiCredentialID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Reading allowed origin...")
+
+	for _, allowedOrigin := range allowedOriginsResponse.Data {
+		if allowedOrigin.GetId() == state.ID.ValueString() {
+			state.Domain = types.StringValue(allowedOrigin.Domain)
+
+			// Set state
+			diags = resp.State.Set(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	// The allowed origin was removed outside of Terraform, e.g. in the Customer Area, so it is planned to be created again.
+	resp.State.RemoveResource(ctx)
+}
+
+// Update only stores the planned values, as every configurable attribute requires a replacement.
+func (r *allowedOriginResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan allowedOriginResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *allowedOriginResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state allowedOriginResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if state.CompanyAccount.ValueString() != "" {
+		deleteAllowedOriginInput := r.client.Management().AllowedOriginsCompanyLevelApi.DeleteAllowedOriginInput(state.CompanyAccount.ValueString(), state.ApiCredentialID.ValueString(), state.ID.ValueString())
+		httpRes, err = r.client.Management().AllowedOriginsCompanyLevelApi.DeleteAllowedOrigin(ctx, deleteAllowedOriginInput)
+	} else {
+		deleteAllowedOriginInput := r.client.Management().AllowedOriginsMerchantLevelApi.DeleteAllowedOriginInput(r.client.GetConfig().MerchantAccount, state.ApiCredentialID.ValueString(), state.ID.ValueString())
+		httpRes, err = r.client.Management().AllowedOriginsMerchantLevelApi.DeleteAllowedOrigin(ctx, deleteAllowedOriginInput)
+	}
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // The allowed origin or its API credential no longer exists, so there is nothing to delete.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Allowed Origin",
+			"Could not delete allowed origin "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing allowed origin using an identifier of the form "<merchant_account>/<api_credential_id>/<origin_id>" for a merchant API credential,
+// or "company/<company_account>/<api_credential_id>/<origin_id>" for a company API credential.
+func (r *allowedOriginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	for _, part := range parts {
+		if part == "" {
+			parts = nil
+		}
+	}
+	switch {
+	case len(parts) == 3:
+		// Allowed origins of a merchant API credential are bound to the merchant account of the provider.
+		if parts[0] != r.client.GetConfig().MerchantAccount {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Merchant account '%s' does not match the merchant account configured for the provider.", parts[0]),
+			)
+			return
+		}
+	case len(parts) == 4 && parts[0] == "company":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("company_account"), parts[1])...)
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import identifier of the form '<merchant_account>/<api_credential_id>/<origin_id>' or 'company/<company_account>/<api_credential_id>/<origin_id>': got '"+req.ID+"'",
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the identifying attributes, Read fills in the domain.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("api_credential_id"), parts[len(parts)-2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[len(parts)-1])...)
+}
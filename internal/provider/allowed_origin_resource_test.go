@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccAllowedOriginResource(t *testing.T) {
+	merchantResourceName := "adyen_allowed_origin.merchant"
+	companyResourceName := "adyen_allowed_origin.company"
+	var apiCredentialID, originID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenAllowedOriginDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigAllowedOrigin(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(merchantResourceName, "id"),
+					resource.TestCheckResourceAttrPair(merchantResourceName, "api_credential_id", "adyen_api_credential_merchant.test", "id"),
+					resource.TestCheckResourceAttr(merchantResourceName, "domain", "https://www.example.com"),
+					resource.TestCheckResourceAttrSet(companyResourceName, "id"),
+					resource.TestCheckResourceAttr(companyResourceName, "company_account", "WeaveAccount"),
+					resource.TestCheckResourceAttr(companyResourceName, "domain", "https://shop.example.com"),
+					func(s *terraform.State) error {
+						apiCredentialID = s.RootModule().Resources[merchantResourceName].Primary.Attributes["api_credential_id"]
+						originID = s.RootModule().Resources[merchantResourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      merchantResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[merchantResourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", merchantResourceName)
+					}
+					return os.Getenv("ADYEN_API_MERCHANT_ACCOUNT") + "/" + rs.Primary.Attributes["api_credential_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				ResourceName:      companyResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[companyResourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", companyResourceName)
+					}
+					return "company/" + rs.Primary.Attributes["company_account"] + "/" + rs.Primary.Attributes["api_credential_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				ResourceName:  merchantResourceName,
+				ImportState:   true,
+				ImportStateId: "OtherMerchantAccount/S2-00000000000000000000/AO0000000000000000000000",
+				ExpectError:   regexp.MustCompile(`does not match the merchant account\s+configured for the provider`),
+			},
+			{
+				// Removing the allowed origin outside of Terraform must show up as drift.
+				PreConfig: func() {
+					suite := new(AcceptanceSuite)
+					suite.SetupSuite()
+					client := suite.client
+
+					deleteAllowedOriginInput := client.Management().AllowedOriginsMerchantLevelApi.DeleteAllowedOriginInput(client.GetConfig().MerchantAccount, apiCredentialID, originID)
+					if _, err := client.Management().AllowedOriginsMerchantLevelApi.DeleteAllowedOrigin(context.Background(), deleteAllowedOriginInput); err != nil {
+						t.Fatalf("could not delete allowed origin %s: %s", originID, err)
+					}
+				},
+				Config:             testProviderClientFromTmpl(t) + testConfigAllowedOrigin(),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAdyenAllowedOriginDestroy(tfstate *terraform.State) error {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	for _, rs := range tfstate.RootModule().Resources {
+		if rs.Type != "adyen_allowed_origin" {
+			continue
+		}
+
+		if companyAccount := rs.Primary.Attributes["company_account"]; companyAccount != "" {
+			data := client.Management().AllowedOriginsCompanyLevelApi.GetAllowedOriginInput(companyAccount, rs.Primary.Attributes["api_credential_id"], rs.Primary.ID)
+			_, resp, err := client.Management().AllowedOriginsCompanyLevelApi.GetAllowedOrigin(context.Background(), data)
+			if err == nil {
+				return fmt.Errorf("adyen_allowed_origin with id: '%s' still exists", rs.Primary.ID)
+			}
+			if resp != nil && resp.StatusCode != 422 && resp.StatusCode != 404 {
+				return err
+			}
+			continue
+		}
+
+		data := client.Management().AllowedOriginsMerchantLevelApi.GetAllowedOriginInput(client.GetConfig().MerchantAccount, rs.Primary.Attributes["api_credential_id"], rs.Primary.ID)
+		_, resp, err := client.Management().AllowedOriginsMerchantLevelApi.GetAllowedOrigin(context.Background(), data)
+		if err == nil {
+			return fmt.Errorf("adyen_allowed_origin with id: '%s' still exists", rs.Primary.ID)
+		}
+		if resp != nil && resp.StatusCode != 422 && resp.StatusCode != 404 {
+			return err
+		}
+	}
+	return nil
+}
+
+func testConfigAllowedOrigin() string {
+	return `
+	resource "adyen_api_credential_merchant" "test" {
+		description = "Terraform merchant credential"
+	}
+
+	resource "adyen_allowed_origin" "merchant" {
+		api_credential_id = adyen_api_credential_merchant.test.id
+		domain            = "https://www.example.com"
+	}
+
+	resource "adyen_api_credential_company" "test" {
+		company_account = "WeaveAccount"
+		description     = "Terraform company credential"
+	}
+
+	resource "adyen_allowed_origin" "company" {
+		api_credential_id = adyen_api_credential_company.test.id
+		company_account   = adyen_api_credential_company.test.company_account
+		domain            = "https://shop.example.com"
+	}
+`
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &androidAppsDataSource{}
+	_ datasource.DataSourceWithConfigure = &androidAppsDataSource{}
+)
+
+// Allowed values of the status of an Android app uploaded for payment terminals.
+var androidAppStatuses = []string{"processing", "error", "invalid", "ready", "archived"}
+
+// androidAppsDataSource is the data source implementation.
+type androidAppsDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewAndroidAppsDataSource is a helper function to simplify the provider implementation.
+func NewAndroidAppsDataSource() datasource.DataSource {
+	return &androidAppsDataSource{}
+}
+
+// androidAppsDataSourceModel maps the "android_apps" schema data for a data source.
+type androidAppsDataSourceModel struct {
+	CompanyID   types.String      `tfsdk:"company_id"`
+	PackageName types.String      `tfsdk:"package_name"`
+	VersionCode types.Int64       `tfsdk:"version_code"`
+	Status      types.String      `tfsdk:"status"`
+	IDs         []string          `tfsdk:"ids"`
+	Apps        []androidAppModel `tfsdk:"apps"`
+}
+
+// androidAppModel maps an Android app uploaded for payment terminals.
+type androidAppModel struct {
+	ID          types.String `tfsdk:"id"`
+	PackageName types.String `tfsdk:"package_name"`
+	Label       types.String `tfsdk:"label"`
+	VersionCode types.Int64  `tfsdk:"version_code"`
+	VersionName types.String `tfsdk:"version_name"`
+	Description types.String `tfsdk:"description"`
+	Status      types.String `tfsdk:"status"`
+	ErrorCode   types.String `tfsdk:"error_code"`
+}
+
+// mapAndroidAppModel maps an Android app returned by the Adyen API to the Terraform model.
+func mapAndroidAppModel(app management.AndroidApp) androidAppModel {
+	model := androidAppModel{
+		ID:          types.StringValue(app.Id),
+		PackageName: types.StringPointerValue(app.PackageName),
+		Label:       types.StringPointerValue(app.Label),
+		VersionCode: types.Int64Null(),
+		VersionName: types.StringPointerValue(app.VersionName),
+		Description: types.StringPointerValue(app.Description),
+		Status:      types.StringValue(app.Status),
+		ErrorCode:   types.StringPointerValue(app.ErrorCode),
+	}
+	if app.VersionCode != nil {
+		model.VersionCode = types.Int64Value(int64(*app.VersionCode))
+	}
+	return model
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *androidAppsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *androidAppsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_android_apps"
+}
+
+// Schema defines the schema for the data source.
+func (d *androidAppsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the Android apps uploaded for the payment terminals of a company account, optionally filtered by package name, version code and status. " +
+			"The identifiers can be used to schedule app installs with the adyen_terminal_action resource.\n\n" +
+			"To make this request, your API credential must have one of the following roles:\n\nManagement API—Android files read\nManagement API—Android files read and write",
+		Attributes: map[string]schema.Attribute{
+			"company_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the company account the apps were uploaded for.",
+			},
+			"package_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return apps with this package name, e.g. com.example.pos.",
+			},
+			"version_code": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only return apps with this version code.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return apps with this status: processing, error, invalid, ready or archived.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(androidAppStatuses...),
+				},
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The unique identifiers of the matching apps.",
+			},
+			"apps": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching apps.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the app.",
+						},
+						"package_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The package name that uniquely identifies the Android app.",
+						},
+						"label": schema.StringAttribute{
+							Computed:    true,
+							Description: "The app name that is shown on the terminal.",
+						},
+						"version_code": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The version number of the app.",
+						},
+						"version_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The app version that is shown on the terminal.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description that was provided when uploading the app.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the app: processing, error, invalid, ready or archived.",
+						},
+						"error_code": schema.StringAttribute{
+							Computed:    true,
+							Description: "The error code of the app, if the status is error or invalid.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *androidAppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading Android apps data source...")
+
+	var state androidAppsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.IDs = []string{}
+	state.Apps = []androidAppModel{}
+
+	// The API does not return the number of pages, so keep fetching until a page is not full.
+	for page := int32(1); ; page++ {
+		listAndroidAppsInput := d.client.Management().AndroidFilesCompanyLevelApi.
+			ListAndroidAppsInput(state.CompanyID.ValueString()).
+			PageNumber(page).
+			PageSize(listPageSize)
+		if !state.PackageName.IsNull() {
+			listAndroidAppsInput = listAndroidAppsInput.PackageName(state.PackageName.ValueString())
+		}
+		if !state.VersionCode.IsNull() {
+			listAndroidAppsInput = listAndroidAppsInput.VersionCode(int32(state.VersionCode.ValueInt64()))
+		}
+		listAndroidAppsResponse, _, err := d.client.Management().AndroidFilesCompanyLevelApi.ListAndroidApps(ctx, listAndroidAppsInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Android Apps",
+				"Could not list Android apps of company "+state.CompanyID.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		// The API cannot filter by status, so apps with another status are skipped here.
+		for _, app := range listAndroidAppsResponse.Data {
+			if !state.Status.IsNull() && app.Status != state.Status.ValueString() {
+				continue
+			}
+			state.IDs = append(state.IDs, app.Id)
+			state.Apps = append(state.Apps, mapAndroidAppModel(app))
+		}
+
+		if int32(len(listAndroidAppsResponse.Data)) < listPageSize {
+			break
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccAndroidAppsDataSource(t *testing.T) {
+	allApps := "data.adyen_android_apps.all"
+	readyApps := "data.adyen_android_apps.ready"
+	versionApps := "data.adyen_android_apps.version"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + `
+	data "adyen_android_apps" "invalid" {
+		company_id = "WeaveAccount"
+		status     = "installed"
+	}
+`,
+				ExpectError: regexp.MustCompile(`Attribute status value must be one of`),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + `
+	data "adyen_android_apps" "all" {
+		company_id = "WeaveAccount"
+	}
+
+	data "adyen_android_apps" "ready" {
+		company_id   = "WeaveAccount"
+		package_name = "com.weave.pos"
+		status       = "ready"
+	}
+
+	data "adyen_android_apps" "version" {
+		company_id   = "WeaveAccount"
+		package_name = "com.weave.pos"
+		version_code = 1
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(allApps, "ids.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(allApps, "apps.*", map[string]string{
+						"package_name": "com.weave.kiosk",
+						"status":       "error",
+						"error_code":   "InvalidSignature",
+					}),
+					resource.TestCheckResourceAttr(readyApps, "ids.#", "1"),
+					resource.TestCheckResourceAttr(readyApps, "ids.0", "ANDA422LZ223223K5F694GCCF732K9"),
+					resource.TestCheckResourceAttr(readyApps, "apps.0.label", "Weave POS"),
+					resource.TestCheckResourceAttr(readyApps, "apps.0.version_code", "2"),
+					resource.TestCheckResourceAttr(readyApps, "apps.0.version_name", "1.1.0"),
+					resource.TestCheckResourceAttr(versionApps, "ids.#", "1"),
+					resource.TestCheckResourceAttr(versionApps, "apps.0.status", "archived"),
+				),
+			},
+		},
+	})
+}
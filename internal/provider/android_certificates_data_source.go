@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &androidCertificatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &androidCertificatesDataSource{}
+)
+
+// androidCertificatesDataSource is the data source implementation.
+type androidCertificatesDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewAndroidCertificatesDataSource is a helper function to simplify the provider implementation.
+func NewAndroidCertificatesDataSource() datasource.DataSource {
+	return &androidCertificatesDataSource{}
+}
+
+// androidCertificatesDataSourceModel maps the "android_certificates" schema data for a data source.
+type androidCertificatesDataSourceModel struct {
+	CompanyID    types.String              `tfsdk:"company_id"`
+	Name         types.String              `tfsdk:"name"`
+	Status       types.String              `tfsdk:"status"`
+	IDs          []string                  `tfsdk:"ids"`
+	Certificates []androidCertificateModel `tfsdk:"certificates"`
+}
+
+// androidCertificateModel maps an Android certificate uploaded for payment terminals.
+type androidCertificateModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Extension   types.String `tfsdk:"extension"`
+	Description types.String `tfsdk:"description"`
+	Status      types.String `tfsdk:"status"`
+	NotBefore   types.String `tfsdk:"not_before"`
+	NotAfter    types.String `tfsdk:"not_after"`
+}
+
+// mapAndroidCertificateModel maps an Android certificate returned by the Adyen API to the Terraform model.
+func mapAndroidCertificateModel(certificate management.AndroidCertificate) androidCertificateModel {
+	model := androidCertificateModel{
+		ID:          types.StringValue(certificate.Id),
+		Name:        types.StringPointerValue(certificate.Name),
+		Extension:   types.StringPointerValue(certificate.Extension),
+		Description: types.StringPointerValue(certificate.Description),
+		Status:      types.StringPointerValue(certificate.Status),
+		NotBefore:   types.StringNull(),
+		NotAfter:    types.StringNull(),
+	}
+	if certificate.NotBefore != nil {
+		model.NotBefore = types.StringValue(certificate.NotBefore.Format(time.RFC3339))
+	}
+	if certificate.NotAfter != nil {
+		model.NotAfter = types.StringValue(certificate.NotAfter.Format(time.RFC3339))
+	}
+	return model
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *androidCertificatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *androidCertificatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_android_certificates"
+}
+
+// Schema defines the schema for the data source.
+func (d *androidCertificatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the Android certificates uploaded for the payment terminals of a company account, optionally filtered by name and status. " +
+			"The identifiers can be used to schedule certificate installs with the adyen_terminal_action resource.\n\n" +
+			"To make this request, your API credential must have one of the following roles:\n\nManagement API—Android files read\nManagement API—Android files read and write",
+		Attributes: map[string]schema.Attribute{
+			"company_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the company account the certificates were uploaded for.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return certificates with this file name, e.g. mycert.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return certificates with this status.",
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The unique identifiers of the matching certificates.",
+			},
+			"certificates": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching certificates.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the certificate.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The file name of the certificate.",
+						},
+						"extension": schema.StringAttribute{
+							Computed:    true,
+							Description: "The file format of the certificate, e.g. .cert or .pem.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description that was provided when uploading the certificate.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the certificate.",
+						},
+						"not_before": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time the certificate becomes valid.",
+						},
+						"not_after": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time the certificate stops being valid.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *androidCertificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading Android certificates data source...")
+
+	var state androidCertificatesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.IDs = []string{}
+	state.Certificates = []androidCertificateModel{}
+
+	// The API does not return the number of pages, so keep fetching until a page is not full.
+	for page := int32(1); ; page++ {
+		listAndroidCertificatesInput := d.client.Management().AndroidFilesCompanyLevelApi.
+			ListAndroidCertificatesInput(state.CompanyID.ValueString()).
+			PageNumber(page).
+			PageSize(listPageSize)
+		if !state.Name.IsNull() {
+			listAndroidCertificatesInput = listAndroidCertificatesInput.CertificateName(state.Name.ValueString())
+		}
+		listAndroidCertificatesResponse, _, err := d.client.Management().AndroidFilesCompanyLevelApi.ListAndroidCertificates(ctx, listAndroidCertificatesInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Android Certificates",
+				"Could not list Android certificates of company "+state.CompanyID.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		// The API cannot filter by status, so certificates with another status are skipped here.
+		for _, certificate := range listAndroidCertificatesResponse.Data {
+			if !state.Status.IsNull() && certificate.GetStatus() != state.Status.ValueString() {
+				continue
+			}
+			state.IDs = append(state.IDs, certificate.Id)
+			state.Certificates = append(state.Certificates, mapAndroidCertificateModel(certificate))
+		}
+
+		if int32(len(listAndroidCertificatesResponse.Data)) < listPageSize {
+			break
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccAndroidCertificatesDataSource(t *testing.T) {
+	allCertificates := "data.adyen_android_certificates.all"
+	namedCertificates := "data.adyen_android_certificates.named"
+	readyCertificates := "data.adyen_android_certificates.ready"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + `
+	data "adyen_android_certificates" "all" {
+		company_id = "WeaveAccount"
+	}
+
+	data "adyen_android_certificates" "named" {
+		company_id = "WeaveAccount"
+		name       = "weave-root"
+	}
+
+	data "adyen_android_certificates" "ready" {
+		company_id = "WeaveAccount"
+		status     = "ready"
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(allCertificates, "ids.#", "2"),
+					resource.TestCheckResourceAttr(namedCertificates, "ids.#", "1"),
+					resource.TestCheckResourceAttr(namedCertificates, "ids.0", "ANDC422LZ223223K5F78NVN9SL4VPH"),
+					resource.TestCheckResourceAttr(namedCertificates, "certificates.0.extension", ".pem"),
+					resource.TestCheckResourceAttr(namedCertificates, "certificates.0.description", "Weave root certificate"),
+					resource.TestCheckResourceAttr(namedCertificates, "certificates.0.not_before", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(namedCertificates, "certificates.0.not_after", "2034-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(readyCertificates, "ids.#", "1"),
+					resource.TestCheckResourceAttr(readyCertificates, "certificates.0.name", "weave-root"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &apiCredentialApiKeyResource{}
+	_ resource.ResourceWithConfigure = &apiCredentialApiKeyResource{}
+)
+
+// apiCredentialApiKeyResource generates a new API key for a merchant or company API credential, which invalidates the previous one.
+// Adyen only returns the key when it is generated, so it is kept in the Terraform state.
+type apiCredentialApiKeyResource struct {
+	client *adyen.APIClient
+}
+
+// NewApiCredentialApiKeyResource is a helper function to simplify the provider implementation.
+func NewApiCredentialApiKeyResource() resource.Resource {
+	return &apiCredentialApiKeyResource{}
+}
+
+// apiCredentialApiKeyResourceModel maps the "api_credential_api_key" schema data for a resource.
+type apiCredentialApiKeyResourceModel struct {
+	ApiCredentialID types.String `tfsdk:"api_credential_id"`
+	CompanyAccount  types.String `tfsdk:"company_account"`
+	RotationTrigger types.Map    `tfsdk:"rotation_trigger"`
+	ApiKey          types.String `tfsdk:"api_key"`
+}
+
+// apiCredentialKeyResourceAttributes returns the schema attributes shared by the API key and client key resources.
+func apiCredentialKeyResourceAttributes(key string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"api_credential_id": schema.StringAttribute{
+			Required:    true,
+			Description: "Unique identifier of the API credential to generate the " + key + " for.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"company_account": schema.StringAttribute{
+			Optional:    true,
+			Description: "The company account of a company API credential. If not set, the API credential belongs to the merchant account of the provider.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"rotation_trigger": rotationTriggerAttribute(key),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiCredentialApiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *apiCredentialApiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_credential_api_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiCredentialApiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := apiCredentialKeyResourceAttributes("API key")
+	attributes["api_key"] = schema.StringAttribute{
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The API key generated for the API credential.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Generates a new API key for a merchant or company API credential.\n\n" +
+			"Generating a new API key invalidates the previous one, including the api_key of the API credential resource. Change rotation_trigger to rotate the key.\n\n" +
+			"To make this request, your API credential must have the following roles:\n\nManagement API—API credentials read and write",
+		Attributes: attributes,
+	}
+}
+
+// Create generates a new API key and sets the initial Terraform state.
+func (r *apiCredentialApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Generating adyen API credential API key")
+
+	// Retrieve values from the plan
+	var plan apiCredentialApiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var generateApiKeyResponse management.GenerateApiKeyResponse
+	var err error
+	if plan.CompanyAccount.ValueString() != "" {
+		generateNewApiKeyInput := r.client.Management().APIKeyCompanyLevelApi.GenerateNewApiKeyInput(plan.CompanyAccount.ValueString(), plan.ApiCredentialID.ValueString())
+		generateApiKeyResponse, _, err = r.client.Management().APIKeyCompanyLevelApi.GenerateNewApiKey(ctx, generateNewApiKeyInput)
+	} else {
+		generateNewApiKeyInput := r.client.Management().APIKeyMerchantLevelApi.GenerateNewApiKeyInput(r.client.GetConfig().MerchantAccount, plan.ApiCredentialID.ValueString())
+		generateApiKeyResponse, _, err = r.client.Management().APIKeyMerchantLevelApi.GenerateNewApiKey(ctx, generateNewApiKeyInput)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error generating API credential API key",
+			"Could not generate API key for API credential "+plan.ApiCredentialID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ApiKey = types.StringValue(generateApiKeyResponse.ApiKey)
+
+	// Set state with the generated API key
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read removes the API key from state once its API credential is deleted. Adyen does not return API keys, so otherwise the key is kept as is.
+func (r *apiCredentialApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiCredentialApiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if state.CompanyAccount.ValueString() != "" {
+		getApiCredentialInput := r.client.Management().APICredentialsCompanyLevelApi.GetApiCredentialInput(state.CompanyAccount.ValueString(), state.ApiCredentialID.ValueString())
+		_, httpRes, err = r.client.Management().APICredentialsCompanyLevelApi.GetApiCredential(ctx, getApiCredentialInput)
+	} else {
+		getApiCredentialInput := r.client.Management().APICredentialsMerchantLevelApi.GetApiCredentialInput(r.client.GetConfig().MerchantAccount, state.ApiCredentialID.ValueString())
+		_, httpRes, err = r.client.Management().APICredentialsMerchantLevelApi.GetApiCredential(ctx, getApiCredentialInput)
+	}
+	if !checkGeneratedKeyOwner(ctx, resp, httpRes, err, "Error Reading API Credential API Key", "API credential "+state.ApiCredentialID.ValueString()) {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading API credential API key...")
+}
+
+// Update only stores the planned values, as every attribute that affects the API key requires a replacement.
+func (r *apiCredentialApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan apiCredentialApiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the API key from the Terraform state. The API credential keeps accepting the key until a new one is generated.
+func (r *apiCredentialApiKeyResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	deleteGeneratedKey(ctx, "API credential API key")
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccApiCredentialApiKeyResource(t *testing.T) {
+	resourceName := "adyen_api_credential_api_key.test"
+	var apiKey string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenApiCredentialCompanyDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigApiCredentialCompany("Terraform company credential", `["https://www.example.com"]`, true) + testConfigApiCredentialApiKey("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "api_credential_id", "adyen_api_credential_company.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+					testAccCheckRotatedKey(resourceName, "api_key", &apiKey, false),
+				),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigApiCredentialCompany("Terraform company credential", `["https://www.example.com"]`, true) + testConfigApiCredentialApiKey("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_trigger.version", "2"),
+					testAccCheckRotatedKey(resourceName, "api_key", &apiKey, true),
+				),
+			},
+		},
+	})
+}
+
+func testConfigApiCredentialApiKey(version string) string {
+	return fmt.Sprintf(`
+	resource "adyen_api_credential_api_key" "test" {
+		api_credential_id = adyen_api_credential_company.test.id
+		company_account   = adyen_api_credential_company.test.company_account
+		rotation_trigger = {
+			version = "%s"
+		}
+	}
+`, version)
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &apiCredentialClientKeyResource{}
+	_ resource.ResourceWithConfigure = &apiCredentialClientKeyResource{}
+)
+
+// apiCredentialClientKeyResource generates a new client key for a merchant or company API credential, which invalidates the previous one.
+// Unlike API keys, Adyen returns the client key of an API credential, so it is refreshed on every read.
+type apiCredentialClientKeyResource struct {
+	client *adyen.APIClient
+}
+
+// NewApiCredentialClientKeyResource is a helper function to simplify the provider implementation.
+func NewApiCredentialClientKeyResource() resource.Resource {
+	return &apiCredentialClientKeyResource{}
+}
+
+// apiCredentialClientKeyResourceModel maps the "api_credential_client_key" schema data for a resource.
+type apiCredentialClientKeyResourceModel struct {
+	ApiCredentialID types.String `tfsdk:"api_credential_id"`
+	CompanyAccount  types.String `tfsdk:"company_account"`
+	RotationTrigger types.Map    `tfsdk:"rotation_trigger"`
+	ClientKey       types.String `tfsdk:"client_key"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiCredentialClientKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *apiCredentialClientKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_credential_client_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiCredentialClientKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := apiCredentialKeyResourceAttributes("client key")
+	attributes["client_key"] = schema.StringAttribute{
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The client key generated for the API credential.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Generates a new client key for a merchant or company API credential.\n\n" +
+			"Generating a new client key invalidates the previous one. Change rotation_trigger to rotate the key.\n\n" +
+			"To make this request, your API credential must have the following roles:\n\nManagement API—API credentials read and write",
+		Attributes: attributes,
+	}
+}
+
+// Create generates a new client key and sets the initial Terraform state.
+func (r *apiCredentialClientKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Generating adyen API credential client key")
+
+	// Retrieve values from the plan
+	var plan apiCredentialClientKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var generateClientKeyResponse management.GenerateClientKeyResponse
+	var err error
+	if plan.CompanyAccount.ValueString() != "" {
+		generateNewClientKeyInput := r.client.Management().ClientKeyCompanyLevelApi.GenerateNewClientKeyInput(plan.CompanyAccount.ValueString(), plan.ApiCredentialID.ValueString())
+		generateClientKeyResponse, _, err = r.client.Management().ClientKeyCompanyLevelApi.GenerateNewClientKey(ctx, generateNewClientKeyInput)
+	} else {
+		generateNewClientKeyInput := r.client.Management().ClientKeyMerchantLevelApi.GenerateNewClientKeyInput(r.client.GetConfig().MerchantAccount, plan.ApiCredentialID.ValueString())
+		generateClientKeyResponse, _, err = r.client.Management().ClientKeyMerchantLevelApi.GenerateNewClientKey(ctx, generateNewClientKeyInput)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error generating API credential client key",
+			"Could not generate client key for API credential "+plan.ApiCredentialID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ClientKey = types.StringValue(generateClientKeyResponse.ClientKey)
+
+	// Set state with the generated client key
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the client key from its API credential, removing the resource if the API credential no longer exists.
+func (r *apiCredentialClientKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiCredentialClientKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var clientKey string
+	var httpRes *http.Response
+	var err error
+	if state.CompanyAccount.ValueString() != "" {
+		getApiCredentialInput := r.client.Management().APICredentialsCompanyLevelApi.GetApiCredentialInput(state.CompanyAccount.ValueString(), state.ApiCredentialID.ValueString())
+		var credential management.CompanyApiCredential
+		credential, httpRes, err = r.client.Management().APICredentialsCompanyLevelApi.GetApiCredential(ctx, getApiCredentialInput)
+		clientKey = credential.ClientKey
+	} else {
+		getApiCredentialInput := r.client.Management().APICredentialsMerchantLevelApi.GetApiCredentialInput(r.client.GetConfig().MerchantAccount, state.ApiCredentialID.ValueString())
+		var credential management.ApiCredential
+		credential, httpRes, err = r.client.Management().APICredentialsMerchantLevelApi.GetApiCredential(ctx, getApiCredentialInput)
+		clientKey = credential.ClientKey
+	}
+	if !checkGeneratedKeyOwner(ctx, resp, httpRes, err, "Error Reading API Credential Client Key", "API credential "+state.ApiCredentialID.ValueString()) {
+		return
+	}
+
+	state.ClientKey = types.StringValue(clientKey)
+
+	tflog.Debug(ctx, "Reading API credential client key...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only stores the planned values, as every attribute that affects the client key requires a replacement.
+func (r *apiCredentialClientKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan apiCredentialClientKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the client key from the Terraform state. The API credential keeps its client key until a new one is generated.
+func (r *apiCredentialClientKeyResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	deleteGeneratedKey(ctx, "API credential client key")
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccApiCredentialClientKeyResource(t *testing.T) {
+	resourceName := "adyen_api_credential_client_key.test"
+	var clientKey string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenApiCredentialMerchantDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigApiCredentialClientKey("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "api_credential_id", "adyen_api_credential_merchant.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_key"),
+					testAccCheckRotatedKey(resourceName, "client_key", &clientKey, false),
+				),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigApiCredentialClientKey("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_trigger.version", "2"),
+					testAccCheckRotatedKey(resourceName, "client_key", &clientKey, true),
+				),
+			},
+		},
+	})
+}
+
+func testConfigApiCredentialClientKey(version string) string {
+	return fmt.Sprintf(`
+	resource "adyen_api_credential_merchant" "test" {
+		description = "Terraform merchant credential"
+	}
+
+	resource "adyen_api_credential_client_key" "test" {
+		api_credential_id = adyen_api_credential_merchant.test.id
+		rotation_trigger = {
+			version = "%s"
+		}
+	}
+`, version)
+}
@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &apiCredentialCompanyResource{}
+	_ resource.ResourceWithConfigure   = &apiCredentialCompanyResource{}
+	_ resource.ResourceWithImportState = &apiCredentialCompanyResource{}
+)
+
+// apiCredentialCompanyResource is the resource implementation.
+type apiCredentialCompanyResource struct {
+	client *adyen.APIClient
+}
+
+// NewApiCredentialCompanyResource is a helper function to simplify the provider implementation.
+func NewApiCredentialCompanyResource() resource.Resource {
+	return &apiCredentialCompanyResource{}
+}
+
+// apiCredentialCompanyResourceModel maps the "api_credential_company" schema data for a resource.
+type apiCredentialCompanyResourceModel struct {
+	CompanyAccount             types.String `tfsdk:"company_account"`
+	ID                         types.String `tfsdk:"id"`
+	Username                   types.String `tfsdk:"username"`
+	Description                types.String `tfsdk:"description"`
+	Roles                      types.Set    `tfsdk:"roles"`
+	AllowedOrigins             types.Set    `tfsdk:"allowed_origins"`
+	AllowedIpAddresses         types.Set    `tfsdk:"allowed_ip_addresses"`
+	AssociatedMerchantAccounts types.Set    `tfsdk:"associated_merchant_accounts"`
+	Active                     types.Bool   `tfsdk:"active"`
+	ClientKey                  types.String `tfsdk:"client_key"`
+	ApiKey                     types.String `tfsdk:"api_key"`
+	Password                   types.String `tfsdk:"password"`
+}
+
+// mapApiCredentialCompanyModel maps a company API credential returned by the Adyen API to its Terraform model.
+// Adyen only returns the API key and password when the credential is created, so they are left for the caller to fill in.
+func mapApiCredentialCompanyModel(companyAccount string, credential management.CompanyApiCredential) apiCredentialCompanyResourceModel {
+	return apiCredentialCompanyResourceModel{
+		CompanyAccount:             types.StringValue(companyAccount),
+		ID:                         types.StringValue(credential.Id),
+		Username:                   types.StringValue(credential.Username),
+		Description:                types.StringPointerValue(credential.Description),
+		Roles:                      mapStringsToSet(credential.Roles),
+		AllowedOrigins:             mapAllowedOriginsToSet(credential.AllowedOrigins),
+		AllowedIpAddresses:         mapStringsToSet(credential.AllowedIpAddresses),
+		AssociatedMerchantAccounts: mapStringsToSet(credential.AssociatedMerchantAccounts),
+		Active:                     types.BoolValue(credential.Active),
+		ClientKey:                  types.StringValue(credential.ClientKey),
+		ApiKey:                     types.StringNull(),
+		Password:                   types.StringNull(),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiCredentialCompanyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *apiCredentialCompanyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_credential_company"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiCredentialCompanyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := apiCredentialResourceAttributes()
+	attributes["company_account"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The company account of your Adyen Dashboard Environment. Changing it forces a new API credential to be created.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["associated_merchant_accounts"] = schema.SetAttribute{
+		Optional:    true,
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "List of merchant accounts that the API credential has explicit access to. If not set, the API credential has access to all merchant accounts of the company.",
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages an API credential of a company account.\n\n" +
+			"Adyen does not allow deleting API credentials, so destroying this resource deactivates the API credential instead.\n\n" +
+			"To make this request, your API credential must have the following roles:\n\nManagement API—API credentials read and write",
+		Attributes: attributes,
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *apiCredentialCompanyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen company API credential")
+
+	// Retrieve values from the plan
+	var plan apiCredentialCompanyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, diags := mapSetToStrings(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	allowedOrigins, diags := mapSetToStrings(ctx, plan.AllowedOrigins)
+	resp.Diagnostics.Append(diags...)
+	associatedMerchantAccounts, diags := mapSetToStrings(ctx, plan.AssociatedMerchantAccounts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	createCompanyApiCredentialRequest := management.CreateCompanyApiCredentialRequest{
+		Description:                knownStringPointer(plan.Description),
+		Roles:                      roles,
+		AllowedOrigins:             allowedOrigins,
+		AssociatedMerchantAccounts: associatedMerchantAccounts,
+	}
+
+	companyAccount := plan.CompanyAccount.ValueString()
+	createApiCredentialInput := r.client.Management().APICredentialsCompanyLevelApi.
+		CreateApiCredentialInput(companyAccount).
+		CreateCompanyApiCredentialRequest(createCompanyApiCredentialRequest)
+	createApiCredentialResponse, _, err := r.client.Management().APICredentialsCompanyLevelApi.CreateApiCredential(ctx, createApiCredentialInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating company API credential",
+			"Could not create company API credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	credential := management.CompanyApiCredential{
+		Links:                      createApiCredentialResponse.Links,
+		Active:                     createApiCredentialResponse.Active,
+		AllowedIpAddresses:         createApiCredentialResponse.AllowedIpAddresses,
+		AllowedOrigins:             createApiCredentialResponse.AllowedOrigins,
+		AssociatedMerchantAccounts: createApiCredentialResponse.AssociatedMerchantAccounts,
+		ClientKey:                  createApiCredentialResponse.ClientKey,
+		Description:                createApiCredentialResponse.Description,
+		Id:                         createApiCredentialResponse.Id,
+		Roles:                      createApiCredentialResponse.Roles,
+		Username:                   createApiCredentialResponse.Username,
+	}
+
+	// API credentials are always created as active, so deactivate the API credential right away if requested.
+	if !plan.Active.IsUnknown() && plan.Active.ValueBool() != credential.Active {
+		updateApiCredentialInput := r.client.Management().APICredentialsCompanyLevelApi.
+			UpdateApiCredentialInput(companyAccount, credential.Id).
+			UpdateCompanyApiCredentialRequest(management.UpdateCompanyApiCredentialRequest{Active: plan.Active.ValueBoolPointer()})
+		credential, _, err = r.client.Management().APICredentialsCompanyLevelApi.UpdateApiCredential(ctx, updateApiCredentialInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating company API credential",
+				"Could not set the active flag of company API credential "+createApiCredentialResponse.Id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate with attribute values
+	plan = mapApiCredentialCompanyModel(companyAccount, credential)
+	plan.ApiKey = types.StringValue(createApiCredentialResponse.ApiKey)
+	plan.Password = types.StringValue(createApiCredentialResponse.Password)
+
+	// Set state with the fully populated API credential
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apiCredentialCompanyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiCredentialCompanyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	companyAccount := state.CompanyAccount.ValueString()
+	getApiCredentialInput := r.client.Management().APICredentialsCompanyLevelApi.GetApiCredentialInput(companyAccount, state.ID.ValueString())
+	credential, httpRes, err := r.client.Management().APICredentialsCompanyLevelApi.GetApiCredential(ctx, getApiCredentialInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the API credential does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading API Credential Company",
+			"Could not read company API credential "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Adyen only returns the API key and password on creation, so keep the ones from the prior state.
+	apiKey, password := state.ApiKey, state.Password
+	state = mapApiCredentialCompanyModel(companyAccount, credential)
+	state.ApiKey, state.Password = apiKey, password
+
+	tflog.Debug(ctx, "Reading company API credential...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *apiCredentialCompanyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating adyen company API credential")
+
+	// Retrieve values from the plan
+	var plan apiCredentialCompanyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, diags := mapSetToStrings(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	allowedOrigins, diags := mapSetToStrings(ctx, plan.AllowedOrigins)
+	resp.Diagnostics.Append(diags...)
+	associatedMerchantAccounts, diags := mapSetToStrings(ctx, plan.AssociatedMerchantAccounts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updateCompanyApiCredentialRequest := management.UpdateCompanyApiCredentialRequest{
+		Description:                knownStringPointer(plan.Description),
+		Roles:                      roles,
+		AllowedOrigins:             allowedOrigins,
+		AssociatedMerchantAccounts: associatedMerchantAccounts,
+		Active:                     knownBoolPointer(plan.Active),
+	}
+
+	companyAccount := plan.CompanyAccount.ValueString()
+	updateApiCredentialInput := r.client.Management().APICredentialsCompanyLevelApi.
+		UpdateApiCredentialInput(companyAccount, plan.ID.ValueString()).
+		UpdateCompanyApiCredentialRequest(updateCompanyApiCredentialRequest)
+	credential, _, err := r.client.Management().APICredentialsCompanyLevelApi.UpdateApiCredential(ctx, updateApiCredentialInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating company API credential",
+			"Could not update company API credential "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	apiKey, password := plan.ApiKey, plan.Password
+	plan = mapApiCredentialCompanyModel(companyAccount, credential)
+	plan.ApiKey, plan.Password = apiKey, password
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deactivates the API credential and removes the Terraform state on success, as Adyen does not allow deleting API credentials.
+func (r *apiCredentialCompanyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiCredentialCompanyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateApiCredentialInput := r.client.Management().APICredentialsCompanyLevelApi.
+		UpdateApiCredentialInput(state.CompanyAccount.ValueString(), state.ID.ValueString()).
+		UpdateCompanyApiCredentialRequest(management.UpdateCompanyApiCredentialRequest{Active: common.PtrBool(false)})
+	_, httpRes, err := r.client.Management().APICredentialsCompanyLevelApi.UpdateApiCredential(ctx, updateApiCredentialInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // The API credential no longer exists, so there is nothing to deactivate.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting API Credential Company",
+			"Could not deactivate company API credential "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing API credential using an identifier of the form "<company_account>/<api_credential_id>".
+func (r *apiCredentialCompanyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	companyAccount, apiCredentialID, err := splitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import identifier of the form '<company_account>/<api_credential_id>': "+err.Error(),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the company_account and id attributes, Read fills in the remaining state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("company_account"), companyAccount)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), apiCredentialID)...)
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func testAccCheckAdyenApiCredentialCompanyDestroy(tfstate *terraform.State) error {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	for _, rs := range tfstate.RootModule().Resources {
+		if rs.Type != "adyen_api_credential_company" {
+			continue
+		}
+
+		// Adyen does not allow deleting API credentials, so destroying the resource deactivates the API credential.
+		data := client.Management().APICredentialsCompanyLevelApi.GetApiCredentialInput(rs.Primary.Attributes["company_account"], rs.Primary.ID)
+		credential, resp, err := client.Management().APICredentialsCompanyLevelApi.GetApiCredential(context.Background(), data)
+		if resp != nil && resp.StatusCode == 422 { // 422 Unprocessable Entity error code from Adyen if resource does not exist.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if credential.Active {
+			return fmt.Errorf("adyen_api_credential_company with id: '%s' is still active", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccApiCredentialCompanyResource(t *testing.T) {
+	resourceName := "adyen_api_credential_company.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenApiCredentialCompanyDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigApiCredentialCompany("Terraform company credential", `["https://www.example.com"]`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "username"),
+					resource.TestCheckResourceAttr(resourceName, "company_account", "WeaveAccount"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform company credential"),
+					resource.TestCheckResourceAttr(resourceName, "associated_merchant_accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "associated_merchant_accounts.*", "WeaveAccountECOM"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "password"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Adyen only returns the API key and password when the API credential is created.
+				ImportStateVerifyIgnore: []string{"api_key", "password"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+					return rs.Primary.Attributes["company_account"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigApiCredentialCompany("Updated company credential", `["https://www.example.com", "https://shop.example.com"]`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated company credential"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://shop.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+				),
+			},
+		},
+	})
+}
+
+func testConfigApiCredentialCompany(description string, allowedOrigins string, active bool) string {
+	return fmt.Sprintf(`
+	resource "adyen_api_credential_company" "test" {
+		company_account              = "WeaveAccount"
+		description                  = "%s"
+		roles                        = ["Checkout webservice role", "Management API - Accounts read"]
+		allowed_origins              = %s
+		associated_merchant_accounts = ["WeaveAccountECOM"]
+		active                       = %t
+	}
+`, description, allowedOrigins, active)
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &apiCredentialMeDataSource{}
+	_ datasource.DataSourceWithConfigure = &apiCredentialMeDataSource{}
+)
+
+// apiCredentialMeDataSource is the data source implementation.
+type apiCredentialMeDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewApiCredentialMeDataSource is a helper function to simplify the provider implementation.
+func NewApiCredentialMeDataSource() datasource.DataSource {
+	return &apiCredentialMeDataSource{}
+}
+
+// apiCredentialMeDataSourceModel maps the "api_credential_me" schema data for a data source.
+type apiCredentialMeDataSourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	Username                   types.String `tfsdk:"username"`
+	Description                types.String `tfsdk:"description"`
+	CompanyName                types.String `tfsdk:"company_name"`
+	Roles                      types.Set    `tfsdk:"roles"`
+	AllowedOrigins             types.Set    `tfsdk:"allowed_origins"`
+	AllowedIpAddresses         types.Set    `tfsdk:"allowed_ip_addresses"`
+	AssociatedMerchantAccounts types.Set    `tfsdk:"associated_merchant_accounts"`
+	Active                     types.Bool   `tfsdk:"active"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *apiCredentialMeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *apiCredentialMeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_credential_me"
+}
+
+// Schema defines the schema for the data source.
+func (d *apiCredentialMeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the details of the API credential that the provider is configured with, for example to verify its roles in a check block.\n\n" +
+			"This request does not require any specific role.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the API credential.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the API credential, for example ws@Company.TestCompany.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the API credential.",
+			},
+			"company_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the company linked to the API credential.",
+			},
+			"roles": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of roles of the API credential.",
+			},
+			"allowed_origins": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of allowed origins (domains) of the API credential.",
+			},
+			"allowed_ip_addresses": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of IP addresses from which your client can make requests. If empty, requests are allowed from any IP address.",
+			},
+			"associated_merchant_accounts": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of merchant accounts that the API credential has explicit access to. Empty if the API credential has access to the whole company.",
+			},
+			"active": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates if the API credential is enabled.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *apiCredentialMeDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	getApiCredentialDetailsInput := d.client.Management().MyAPICredentialApi.GetApiCredentialDetailsInput()
+	credential, _, err := d.client.Management().MyAPICredentialApi.GetApiCredentialDetails(ctx, getApiCredentialDetailsInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading API Credential Me",
+			"Could not read the API credential of the provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Reading my API credential...")
+
+	// Map response body to model
+	state := apiCredentialMeDataSourceModel{
+		ID:                         types.StringValue(credential.Id),
+		Username:                   types.StringValue(credential.Username),
+		Description:                types.StringPointerValue(credential.Description),
+		CompanyName:                types.StringPointerValue(credential.CompanyName),
+		Roles:                      mapStringsToSet(credential.Roles),
+		AllowedOrigins:             mapAllowedOriginsToSet(credential.AllowedOrigins),
+		AllowedIpAddresses:         mapStringsToSet(credential.AllowedIpAddresses),
+		AssociatedMerchantAccounts: mapStringsToSet(credential.AssociatedMerchantAccounts),
+		Active:                     types.BoolValue(credential.Active),
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccApiCredentialMeDataSource(t *testing.T) {
+	dataSourceName := "data.adyen_api_credential_me.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + `data "adyen_api_credential_me" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "username"),
+					resource.TestCheckResourceAttr(dataSourceName, "active", "true"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "roles.*", "Management API - Webhooks read and write"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderRequiredRoles(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testProviderClientWithRequiredRolesFromTmpl(t, `["Management API - Webhooks read and write", "Terraform missing role"]`) + `data "adyen_api_credential_me" "test" {}`,
+				ExpectError: regexp.MustCompile(`missing the following required\s+roles: Terraform missing role`),
+			},
+			{
+				Config: testProviderClientWithRequiredRolesFromTmpl(t, `["Management API - Webhooks read and write"]`) + `data "adyen_api_credential_me" "test" {}`,
+				Check:  resource.TestCheckResourceAttrSet("data.adyen_api_credential_me.test", "id"),
+			},
+		},
+	})
+}
@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &apiCredentialMerchantResource{}
+	_ resource.ResourceWithConfigure   = &apiCredentialMerchantResource{}
+	_ resource.ResourceWithImportState = &apiCredentialMerchantResource{}
+)
+
+// apiCredentialMerchantResource is the resource implementation.
+type apiCredentialMerchantResource struct {
+	client *adyen.APIClient
+}
+
+// NewApiCredentialMerchantResource is a helper function to simplify the provider implementation.
+func NewApiCredentialMerchantResource() resource.Resource {
+	return &apiCredentialMerchantResource{}
+}
+
+// apiCredentialMerchantResourceModel maps the "api_credential_merchant" schema data for a resource.
+type apiCredentialMerchantResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Username           types.String `tfsdk:"username"`
+	Description        types.String `tfsdk:"description"`
+	Roles              types.Set    `tfsdk:"roles"`
+	AllowedOrigins     types.Set    `tfsdk:"allowed_origins"`
+	AllowedIpAddresses types.Set    `tfsdk:"allowed_ip_addresses"`
+	Active             types.Bool   `tfsdk:"active"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	Password           types.String `tfsdk:"password"`
+}
+
+// mapApiCredentialMerchantModel maps a merchant API credential returned by the Adyen API to its Terraform model.
+// Adyen only returns the API key and password when the credential is created, so they are left for the caller to fill in.
+func mapApiCredentialMerchantModel(credential management.ApiCredential) apiCredentialMerchantResourceModel {
+	return apiCredentialMerchantResourceModel{
+		ID:                 types.StringValue(credential.Id),
+		Username:           types.StringValue(credential.Username),
+		Description:        types.StringPointerValue(credential.Description),
+		Roles:              mapStringsToSet(credential.Roles),
+		AllowedOrigins:     mapAllowedOriginsToSet(credential.AllowedOrigins),
+		AllowedIpAddresses: mapStringsToSet(credential.AllowedIpAddresses),
+		Active:             types.BoolValue(credential.Active),
+		ClientKey:          types.StringValue(credential.ClientKey),
+		ApiKey:             types.StringNull(),
+		Password:           types.StringNull(),
+	}
+}
+
+// mapAllowedOriginsToSet maps the allowed origins of an API credential to a Terraform set of their domains.
+func mapAllowedOriginsToSet(allowedOrigins []management.AllowedOrigin) types.Set {
+	domains := make([]string, 0, len(allowedOrigins))
+	for _, allowedOrigin := range allowedOrigins {
+		domains = append(domains, allowedOrigin.Domain)
+	}
+	return mapStringsToSet(domains)
+}
+
+// apiCredentialResourceAttributes returns the schema attributes shared by merchant and company API credentials.
+func apiCredentialResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier of the API credential.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"username": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the API credential, for example ws@Company.TestCompany.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Description of the API credential.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"roles": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "List of roles for the API credential. If not set, Adyen assigns the default roles of a new API credential.",
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"allowed_origins": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "List of allowed origins (domains) for the API credential, required for client-side requests with the client key.",
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"allowed_ip_addresses": schema.SetAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "List of IP addresses from which your client can make requests. If empty, requests are allowed from any IP address.",
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"active": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Indicates if the API credential is enabled. New API credentials are active by default.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"client_key": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Public key used for client-side authentication. The client key is required for Drop-in and Components integrations.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"api_key": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The API key for the API credential. Adyen only returns the API key when the API credential is created, so it is empty after an import.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"password": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The password for the API credential, used for basic authentication. Adyen only returns the password when the API credential is created, so it is empty after an import.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiCredentialMerchantResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *apiCredentialMerchantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_credential_merchant"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiCredentialMerchantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an API credential for the merchant account of the provider.\n\n" +
+			"Adyen does not allow deleting API credentials, so destroying this resource deactivates the API credential instead.\n\n" +
+			"To make this request, your API credential must have the following roles:\n\nManagement API—API credentials read and write",
+		Attributes: apiCredentialResourceAttributes(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *apiCredentialMerchantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen merchant API credential")
+
+	// Retrieve values from the plan
+	var plan apiCredentialMerchantResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, diags := mapSetToStrings(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	allowedOrigins, diags := mapSetToStrings(ctx, plan.AllowedOrigins)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	createMerchantApiCredentialRequest := management.CreateMerchantApiCredentialRequest{
+		Description:    knownStringPointer(plan.Description),
+		Roles:          roles,
+		AllowedOrigins: allowedOrigins,
+	}
+
+	createApiCredentialInput := r.client.Management().APICredentialsMerchantLevelApi.
+		CreateApiCredentialInput(r.client.GetConfig().MerchantAccount).
+		CreateMerchantApiCredentialRequest(createMerchantApiCredentialRequest)
+	createApiCredentialResponse, _, err := r.client.Management().APICredentialsMerchantLevelApi.CreateApiCredential(ctx, createApiCredentialInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating merchant API credential",
+			"Could not create merchant API credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	credential := management.ApiCredential{
+		Links:              createApiCredentialResponse.Links,
+		Active:             createApiCredentialResponse.Active,
+		AllowedIpAddresses: createApiCredentialResponse.AllowedIpAddresses,
+		AllowedOrigins:     createApiCredentialResponse.AllowedOrigins,
+		ClientKey:          createApiCredentialResponse.ClientKey,
+		Description:        createApiCredentialResponse.Description,
+		Id:                 createApiCredentialResponse.Id,
+		Roles:              createApiCredentialResponse.Roles,
+		Username:           createApiCredentialResponse.Username,
+	}
+
+	// API credentials are always created as active, so deactivate the API credential right away if requested.
+	if !plan.Active.IsUnknown() && plan.Active.ValueBool() != credential.Active {
+		updateApiCredentialInput := r.client.Management().APICredentialsMerchantLevelApi.
+			UpdateApiCredentialInput(r.client.GetConfig().MerchantAccount, credential.Id).
+			UpdateMerchantApiCredentialRequest(management.UpdateMerchantApiCredentialRequest{Active: plan.Active.ValueBoolPointer()})
+		credential, _, err = r.client.Management().APICredentialsMerchantLevelApi.UpdateApiCredential(ctx, updateApiCredentialInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating merchant API credential",
+				"Could not set the active flag of merchant API credential "+createApiCredentialResponse.Id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate with attribute values
+	plan = mapApiCredentialMerchantModel(credential)
+	plan.ApiKey = types.StringValue(createApiCredentialResponse.ApiKey)
+	plan.Password = types.StringValue(createApiCredentialResponse.Password)
+
+	// Set state with the fully populated API credential
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apiCredentialMerchantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiCredentialMerchantResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getApiCredentialInput := r.client.Management().APICredentialsMerchantLevelApi.GetApiCredentialInput(r.client.GetConfig().MerchantAccount, state.ID.ValueString())
+	credential, httpRes, err := r.client.Management().APICredentialsMerchantLevelApi.GetApiCredential(ctx, getApiCredentialInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the API credential does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading API Credential Merchant",
+			"Could not read merchant API credential "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Adyen only returns the API key and password on creation, so keep the ones from the prior state.
+	apiKey, password := state.ApiKey, state.Password
+	state = mapApiCredentialMerchantModel(credential)
+	state.ApiKey, state.Password = apiKey, password
+
+	tflog.Debug(ctx, "Reading merchant API credential...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *apiCredentialMerchantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating adyen merchant API credential")
+
+	// Retrieve values from the plan
+	var plan apiCredentialMerchantResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, diags := mapSetToStrings(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	allowedOrigins, diags := mapSetToStrings(ctx, plan.AllowedOrigins)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updateMerchantApiCredentialRequest := management.UpdateMerchantApiCredentialRequest{
+		Description:    knownStringPointer(plan.Description),
+		Roles:          roles,
+		AllowedOrigins: allowedOrigins,
+		Active:         knownBoolPointer(plan.Active),
+	}
+
+	updateApiCredentialInput := r.client.Management().APICredentialsMerchantLevelApi.
+		UpdateApiCredentialInput(r.client.GetConfig().MerchantAccount, plan.ID.ValueString()).
+		UpdateMerchantApiCredentialRequest(updateMerchantApiCredentialRequest)
+	credential, _, err := r.client.Management().APICredentialsMerchantLevelApi.UpdateApiCredential(ctx, updateApiCredentialInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating merchant API credential",
+			"Could not update merchant API credential "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	apiKey, password := plan.ApiKey, plan.Password
+	plan = mapApiCredentialMerchantModel(credential)
+	plan.ApiKey, plan.Password = apiKey, password
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deactivates the API credential and removes the Terraform state on success, as Adyen does not allow deleting API credentials.
+func (r *apiCredentialMerchantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiCredentialMerchantResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateApiCredentialInput := r.client.Management().APICredentialsMerchantLevelApi.
+		UpdateApiCredentialInput(r.client.GetConfig().MerchantAccount, state.ID.ValueString()).
+		UpdateMerchantApiCredentialRequest(management.UpdateMerchantApiCredentialRequest{Active: common.PtrBool(false)})
+	_, httpRes, err := r.client.Management().APICredentialsMerchantLevelApi.UpdateApiCredential(ctx, updateApiCredentialInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // The API credential no longer exists, so there is nothing to deactivate.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting API Credential Merchant",
+			"Could not deactivate merchant API credential "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing API credential using an identifier of the form "<merchant_account>/<api_credential_id>".
+func (r *apiCredentialMerchantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	merchantAccount, apiCredentialID, err := splitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import identifier of the form '<merchant_account>/<api_credential_id>': "+err.Error(),
+		)
+		return
+	}
+
+	// The merchant API credential resource is bound to the merchant account of the provider.
+	if merchantAccount != r.client.GetConfig().MerchantAccount {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Merchant account '%s' does not match the merchant account configured for the provider.", merchantAccount),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the id attribute, Read fills in the remaining state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), apiCredentialID)...)
+}
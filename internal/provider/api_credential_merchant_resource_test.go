@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func testAccCheckAdyenApiCredentialMerchantDestroy(tfstate *terraform.State) error {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	for _, rs := range tfstate.RootModule().Resources {
+		if rs.Type != "adyen_api_credential_merchant" {
+			continue
+		}
+
+		// Adyen does not allow deleting API credentials, so destroying the resource deactivates the API credential.
+		data := client.Management().APICredentialsMerchantLevelApi.GetApiCredentialInput(client.GetConfig().MerchantAccount, rs.Primary.ID)
+		credential, resp, err := client.Management().APICredentialsMerchantLevelApi.GetApiCredential(context.Background(), data)
+		if resp != nil && resp.StatusCode == 422 { // 422 Unprocessable Entity error code from Adyen if resource does not exist.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if credential.Active {
+			return fmt.Errorf("adyen_api_credential_merchant with id: '%s' is still active", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccApiCredentialMerchantResource(t *testing.T) {
+	resourceName := "adyen_api_credential_merchant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenApiCredentialMerchantDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + `
+	resource "adyen_api_credential_merchant" "test" {
+		description = "Terraform merchant credential"
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "username"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform merchant credential"),
+					resource.TestCheckResourceAttrSet(resourceName, "roles.#"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "password"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Adyen only returns the API key and password when the API credential is created.
+				ImportStateVerifyIgnore: []string{"api_key", "password"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+					return "WeaveAccountECOM/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + `
+	resource "adyen_api_credential_merchant" "test" {
+		description     = "Terraform merchant credential"
+		roles           = ["Checkout webservice role"]
+		allowed_origins = ["https://www.example.com"]
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "Checkout webservice role"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://www.example.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+				),
+			},
+		},
+	})
+}
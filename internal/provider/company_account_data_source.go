@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &companyAccountDataSource{}
+	_ datasource.DataSourceWithConfigure = &companyAccountDataSource{}
+)
+
+// companyAccountDataSource is the data source implementation.
+type companyAccountDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewCompanyAccountDataSource is a helper function to simplify the provider implementation.
+func NewCompanyAccountDataSource() datasource.DataSource {
+	return &companyAccountDataSource{}
+}
+
+// companyAccountModel maps a company account returned by the Adyen API, together with its merchant accounts.
+type companyAccountModel struct {
+	ID               types.String           `tfsdk:"id"`
+	Name             types.String           `tfsdk:"name"`
+	Description      types.String           `tfsdk:"description"`
+	Reference        types.String           `tfsdk:"reference"`
+	Status           types.String           `tfsdk:"status"`
+	MerchantAccounts []merchantAccountModel `tfsdk:"merchant_accounts"`
+}
+
+// mapCompanyAccountModel maps a company account and its merchant accounts returned by the Adyen API to its Terraform model.
+func mapCompanyAccountModel(company management.Company, merchants []management.Merchant) companyAccountModel {
+	model := companyAccountModel{
+		ID:               types.StringPointerValue(company.Id),
+		Name:             types.StringPointerValue(company.Name),
+		Description:      types.StringPointerValue(company.Description),
+		Reference:        types.StringPointerValue(company.Reference),
+		Status:           types.StringPointerValue(company.Status),
+		MerchantAccounts: []merchantAccountModel{},
+	}
+	for _, merchant := range merchants {
+		model.MerchantAccounts = append(model.MerchantAccounts, mapMerchantAccountModel(merchant))
+	}
+	return model
+}
+
+// companyAccountDataSourceAttributes returns the schema attributes of a company account returned by a data source.
+func companyAccountDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the company account.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The legal or trading name of the company.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Your description for the company account.",
+		},
+		"reference": schema.StringAttribute{
+			Computed:    true,
+			Description: "Your reference for the company account.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the company account: Active, Inactive or Closed.",
+		},
+		"merchant_accounts": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The merchant accounts of the company account.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: merchantAccountDataSourceAttributes(),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *companyAccountDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *companyAccountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_company_account"
+}
+
+// Schema defines the schema for the data source.
+func (d *companyAccountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := companyAccountDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The unique identifier of the company account.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Returns a company account and the merchant accounts under it.\n\n" +
+			"To make this request, your API credential must have one of the following roles:\n\nManagement API—Accounts read\nManagement API—Accounts read and write",
+		Attributes: attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *companyAccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state companyAccountModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading company account data source...")
+
+	getCompanyAccountInput := d.client.Management().AccountCompanyLevelApi.GetCompanyAccountInput(state.ID.ValueString())
+	company, _, err := d.client.Management().AccountCompanyLevelApi.GetCompanyAccount(ctx, getCompanyAccountInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Company Account",
+			"Could not read company account "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	merchants, err := listCompanyMerchantAccounts(ctx, d.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Company Account",
+			"Could not list merchant accounts of company "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = mapCompanyAccountModel(company, merchants)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
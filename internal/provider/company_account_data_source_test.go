@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCompanyAccountDataSource(t *testing.T) {
+	dataSourceName := "data.adyen_company_account.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + `
+	data "adyen_company_account" "test" {
+		id = "WeaveAccount"
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "WeaveAccount"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "Active"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "merchant_accounts.*", map[string]string{
+						"id":         "WeaveAccountECOM",
+						"company_id": "WeaveAccount",
+					}),
+				),
+			},
+		},
+	})
+}
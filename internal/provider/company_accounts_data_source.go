@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &companyAccountsDataSource{}
+	_ datasource.DataSourceWithConfigure = &companyAccountsDataSource{}
+)
+
+// companyAccountsDataSource is the data source implementation.
+type companyAccountsDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewCompanyAccountsDataSource is a helper function to simplify the provider implementation.
+func NewCompanyAccountsDataSource() datasource.DataSource {
+	return &companyAccountsDataSource{}
+}
+
+// companyAccountsDataSourceModel maps the "company_accounts" schema data for a data source.
+type companyAccountsDataSourceModel struct {
+	CompanyAccounts []companyAccountModel `tfsdk:"company_accounts"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *companyAccountsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *companyAccountsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_company_accounts"
+}
+
+// Schema defines the schema for the data source.
+func (d *companyAccountsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns all company accounts that the API credential of the provider has access to, together with the merchant accounts under each of them.\n\n" +
+			"To make this request, your API credential must have one of the following roles:\n\nManagement API—Accounts read\nManagement API—Accounts read and write",
+		Attributes: map[string]schema.Attribute{
+			"company_accounts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The company accounts the API credential has access to.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: companyAccountDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *companyAccountsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading company accounts data source...")
+
+	state := companyAccountsDataSourceModel{CompanyAccounts: []companyAccountModel{}}
+
+	// Adyen returns at most 100 company accounts per page, so keep fetching until all pages are read.
+	for page := int32(1); ; page++ {
+		listCompanyAccountsInput := d.client.Management().AccountCompanyLevelApi.
+			ListCompanyAccountsInput().
+			PageNumber(page).
+			PageSize(listPageSize)
+		listCompanyResponse, _, err := d.client.Management().AccountCompanyLevelApi.ListCompanyAccounts(ctx, listCompanyAccountsInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Company Accounts",
+				"Could not list company accounts, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		for _, company := range listCompanyResponse.Data {
+			merchants, err := listCompanyMerchantAccounts(ctx, d.client, company.GetId())
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading Company Accounts",
+					"Could not list merchant accounts of company "+company.GetId()+", unexpected error: "+err.Error(),
+				)
+				return
+			}
+			state.CompanyAccounts = append(state.CompanyAccounts, mapCompanyAccountModel(company, merchants))
+		}
+
+		if page >= listCompanyResponse.PagesTotal {
+			break
+		}
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCompanyAccountsDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + `
+	data "adyen_company_accounts" "test" {}
+
+	locals {
+		weave_account = one([for company in data.adyen_company_accounts.test.company_accounts : company if company.id == "WeaveAccount"])
+	}
+
+	output "weave_account_has_ecom" {
+		value = contains([for merchant in local.weave_account.merchant_accounts : merchant.id], "WeaveAccountECOM")
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.adyen_company_accounts.test", "company_accounts.*", map[string]string{
+						"id":     "WeaveAccount",
+						"status": "Active",
+					}),
+					resource.TestCheckOutput("weave_account_has_ecom", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &merchantAccountResource{}
+	_ resource.ResourceWithConfigure   = &merchantAccountResource{}
+	_ resource.ResourceWithImportState = &merchantAccountResource{}
+	_ resource.ResourceWithModifyPlan  = &merchantAccountResource{}
+)
+
+// merchantAccountResource is the resource implementation.
+type merchantAccountResource struct {
+	client *adyen.APIClient
+}
+
+// NewMerchantAccountResource is a helper function to simplify the provider implementation.
+func NewMerchantAccountResource() resource.Resource {
+	return &merchantAccountResource{}
+}
+
+// merchantAccountResourceModel maps the "merchant_account" schema data for a resource.
+type merchantAccountResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	CompanyID      types.String `tfsdk:"company_id"`
+	BusinessLineID types.String `tfsdk:"business_line_id"`
+	LegalEntityID  types.String `tfsdk:"legal_entity_id"`
+	Description    types.String `tfsdk:"description"`
+	PricingPlan    types.String `tfsdk:"pricing_plan"`
+	Reference      types.String `tfsdk:"reference"`
+	Activate       types.Bool   `tfsdk:"activate"`
+	Name           types.String `tfsdk:"name"`
+	Status         types.String `tfsdk:"status"`
+}
+
+// mapMerchantAccountResourceModel maps a merchant account returned by the Adyen API onto the Terraform model.
+// Adyen only returns the business line and legal entity of a merchant account when it is created, so those are kept from the model.
+func mapMerchantAccountResourceModel(model *merchantAccountResourceModel, merchant management.Merchant) {
+	model.ID = types.StringPointerValue(merchant.Id)
+	model.CompanyID = types.StringPointerValue(merchant.CompanyId)
+	model.Description = types.StringPointerValue(merchant.Description)
+	model.PricingPlan = types.StringPointerValue(merchant.PricingPlan)
+	model.Reference = types.StringPointerValue(merchant.Reference)
+	model.Name = types.StringPointerValue(merchant.Name)
+	model.Status = types.StringPointerValue(merchant.Status)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *merchantAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *merchantAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_merchant_account"
+}
+
+// Schema defines the schema for the resource.
+func (r *merchantAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a merchant account under a company account.\n\n" +
+			"Adyen does not allow updating or deleting merchant accounts. Changing any attribute other than activate fails during plan, " +
+			"and destroying this resource only removes it from the Terraform state; the merchant account itself can only be closed by Adyen support.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Accounts read and write",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the merchant account. If Adyen set up a template for the reference, the identifier has the same value as the reference.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"company_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the company account the merchant account belongs to.",
+			},
+			"business_line_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the business line. Required for an Adyen for Platforms Manage integration. Adyen only returns it when the merchant account is created, so after an import it is taken from the configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"legal_entity_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the legal entity. Required for an Adyen for Platforms Manage integration. Adyen only returns it when the merchant account is created, so after an import it is taken from the configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Your description for the merchant account, maximum 300 characters.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pricing_plan": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The pricing plan of the merchant account. Required for an Adyen for Platforms Manage integration. Your Adyen contact will provide the values that you can use.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reference": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Your reference for the merchant account. When your company account has a reference template, the reference is required and becomes the identifier of the merchant account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"activate": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set to true to request the activation of the merchant account. Adyen reviews the request, so status only changes to Active once it is approved. A merchant account cannot be deactivated again.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the legal entity associated with the merchant account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the merchant account: PreActive, Active, InactiveWithModifications, Inactive or Closed.",
+			},
+		},
+	}
+}
+
+// requestActivation requests the activation of the merchant account and returns the refreshed merchant account.
+func (r *merchantAccountResource) requestActivation(ctx context.Context, merchantID string) (management.Merchant, error) {
+	requestToActivateMerchantAccountInput := r.client.Management().AccountMerchantLevelApi.RequestToActivateMerchantAccountInput(merchantID)
+	if _, _, err := r.client.Management().AccountMerchantLevelApi.RequestToActivateMerchantAccount(ctx, requestToActivateMerchantAccountInput); err != nil {
+		return management.Merchant{}, err
+	}
+
+	getMerchantAccountInput := r.client.Management().AccountMerchantLevelApi.GetMerchantAccountInput(merchantID)
+	merchant, _, err := r.client.Management().AccountMerchantLevelApi.GetMerchantAccount(ctx, getMerchantAccountInput)
+	return merchant, err
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *merchantAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen merchant account")
+
+	// Retrieve values from the plan
+	var plan merchantAccountResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	createMerchantRequest := management.CreateMerchantRequest{
+		CompanyId:      plan.CompanyID.ValueString(),
+		BusinessLineId: knownStringPointer(plan.BusinessLineID),
+		LegalEntityId:  knownStringPointer(plan.LegalEntityID),
+		Description:    knownStringPointer(plan.Description),
+		PricingPlan:    knownStringPointer(plan.PricingPlan),
+		Reference:      knownStringPointer(plan.Reference),
+	}
+
+	createMerchantAccountInput := r.client.Management().AccountMerchantLevelApi.CreateMerchantAccountInput().CreateMerchantRequest(createMerchantRequest)
+	createMerchantResponse, _, err := r.client.Management().AccountMerchantLevelApi.CreateMerchantAccount(ctx, createMerchantAccountInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating merchant account",
+			"Could not create merchant account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	merchantID := createMerchantResponse.GetId()
+	plan.BusinessLineID = types.StringPointerValue(createMerchantResponse.BusinessLineId)
+	plan.LegalEntityID = types.StringPointerValue(createMerchantResponse.LegalEntityId)
+
+	// The create response lacks the name and status of the merchant account, so read it back.
+	var merchant management.Merchant
+	if plan.Activate.ValueBool() {
+		merchant, err = r.requestActivation(ctx, merchantID)
+	} else {
+		getMerchantAccountInput := r.client.Management().AccountMerchantLevelApi.GetMerchantAccountInput(merchantID)
+		merchant, _, err = r.client.Management().AccountMerchantLevelApi.GetMerchantAccount(ctx, getMerchantAccountInput)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating merchant account",
+			"Could not activate or read merchant account "+merchantID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapMerchantAccountResourceModel(&plan, merchant)
+
+	// Set state with the fully populated merchant account
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *merchantAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state merchantAccountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getMerchantAccountInput := r.client.Management().AccountMerchantLevelApi.GetMerchantAccountInput(state.ID.ValueString())
+	merchant, httpRes, err := r.client.Management().AccountMerchantLevelApi.GetMerchantAccount(ctx, getMerchantAccountInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the merchant account does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Merchant Account",
+			"Could not read merchant account "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapMerchantAccountResourceModel(&state, merchant)
+
+	tflog.Debug(ctx, "Reading merchant account...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan rejects changes to the attributes Adyen does not allow updating, as replacing the resource would leave the old merchant account behind.
+func (r *merchantAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the merchant account is created or removed from state.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state merchantAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attribute := range []struct {
+		name           string
+		planned, state types.String
+		// adoptable attributes are not returned by Adyen, so after an import they are taken from the configuration.
+		adoptable bool
+	}{
+		{"company_id", plan.CompanyID, state.CompanyID, false},
+		{"business_line_id", plan.BusinessLineID, state.BusinessLineID, true},
+		{"legal_entity_id", plan.LegalEntityID, state.LegalEntityID, true},
+		{"description", plan.Description, state.Description, false},
+		{"pricing_plan", plan.PricingPlan, state.PricingPlan, false},
+		{"reference", plan.Reference, state.Reference, false},
+	} {
+		if attribute.planned.IsUnknown() || attribute.planned.Equal(attribute.state) || (attribute.adoptable && attribute.state.IsNull()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute.name),
+			"Merchant Account Cannot Be Updated",
+			fmt.Sprintf("Adyen does not allow changing the %s of merchant account %s from %q to %q. "+
+				"Restore the previous value, or create a new adyen_merchant_account resource; the existing merchant account can only be closed by Adyen support.",
+				attribute.name, state.ID.ValueString(), attribute.state.ValueString(), attribute.planned.ValueString()),
+		)
+	}
+}
+
+// Update requests the activation of the merchant account when activate is switched on. Every other attribute cannot be changed, see ModifyPlan.
+func (r *merchantAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state merchantAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	merchant := management.Merchant{
+		Id:          state.ID.ValueStringPointer(),
+		CompanyId:   state.CompanyID.ValueStringPointer(),
+		Description: state.Description.ValueStringPointer(),
+		PricingPlan: state.PricingPlan.ValueStringPointer(),
+		Reference:   state.Reference.ValueStringPointer(),
+		Name:        state.Name.ValueStringPointer(),
+		Status:      state.Status.ValueStringPointer(),
+	}
+	if plan.Activate.ValueBool() && !state.Activate.ValueBool() {
+		var err error
+		merchant, err = r.requestActivation(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating merchant account",
+				"Could not request the activation of merchant account "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapMerchantAccountResourceModel(&plan, merchant)
+	if plan.BusinessLineID.IsUnknown() {
+		plan.BusinessLineID = state.BusinessLineID
+	}
+	if plan.LegalEntityID.IsUnknown() {
+		plan.LegalEntityID = state.LegalEntityID
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the merchant account from the Terraform state, as Adyen does not allow deleting merchant accounts.
+func (r *merchantAccountResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing merchant account from state")
+}
+
+// ImportState imports an existing merchant account using its identifier.
+func (r *merchantAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
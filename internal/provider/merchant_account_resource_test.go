@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAccMerchantAccountResource(t *testing.T) {
+	resourceName := "adyen_merchant_account.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigMerchantAccount(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "company_id", "WeaveAccount"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform merchant account"),
+					resource.TestCheckResourceAttr(resourceName, "reference", "TerraformMerchant"),
+					resource.TestCheckResourceAttr(resourceName, "status", "PreActive"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Activate only exists in the configuration.
+				ImportStateVerifyIgnore: []string{"activate"},
+			},
+			{
+				// Adyen does not allow updating merchant accounts, so the change must fail during plan instead of creating another merchant account.
+				Config:      testProviderClientFromTmpl(t) + strings.Replace(testConfigMerchantAccount(false), "Terraform merchant account", "Terraform renamed merchant account", 1),
+				ExpectError: regexp.MustCompile(`Adyen does not allow changing the description of merchant account`),
+			},
+			{
+				// Adyen does not return the business line, so configuring one the state does not know yet, e.g. after an import, is taken over.
+				Config: testProviderClientFromTmpl(t) + strings.Replace(testConfigMerchantAccount(false), "activate", "business_line_id = \"SE322KH223222F5GXZFNM3BGP\"\n\t\tactivate", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "business_line_id", "SE322KH223222F5GXZFNM3BGP"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform merchant account"),
+				),
+			},
+			{
+				// Requesting the activation must update the merchant account in place.
+				Config: testProviderClientFromTmpl(t) + testConfigMerchantAccount(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "activate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+				),
+			},
+		},
+	})
+}
+
+func testConfigMerchantAccount(activate bool) string {
+	return fmt.Sprintf(`
+	resource "adyen_merchant_account" "test" {
+		company_id  = "WeaveAccount"
+		description = "Terraform merchant account"
+		reference   = "TerraformMerchant"
+		activate    = %t
+	}
+`, activate)
+}
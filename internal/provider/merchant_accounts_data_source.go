@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &merchantAccountsDataSource{}
+	_ datasource.DataSourceWithConfigure = &merchantAccountsDataSource{}
+)
+
+// merchantAccountsDataSource is the data source implementation.
+type merchantAccountsDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewMerchantAccountsDataSource is a helper function to simplify the provider implementation.
+func NewMerchantAccountsDataSource() datasource.DataSource {
+	return &merchantAccountsDataSource{}
+}
+
+// merchantAccountsDataSourceModel maps the "merchant_accounts" schema data for a data source.
+type merchantAccountsDataSourceModel struct {
+	CompanyID        types.String           `tfsdk:"company_id"`
+	MerchantAccounts []merchantAccountModel `tfsdk:"merchant_accounts"`
+}
+
+// merchantAccountModel maps a merchant account returned by the Adyen API.
+type merchantAccountModel struct {
+	ID                        types.String `tfsdk:"id"`
+	CompanyID                 types.String `tfsdk:"company_id"`
+	Name                      types.String `tfsdk:"name"`
+	Description               types.String `tfsdk:"description"`
+	Reference                 types.String `tfsdk:"reference"`
+	Status                    types.String `tfsdk:"status"`
+	PricingPlan               types.String `tfsdk:"pricing_plan"`
+	MerchantCity              types.String `tfsdk:"merchant_city"`
+	PrimarySettlementCurrency types.String `tfsdk:"primary_settlement_currency"`
+	ShopWebAddress            types.String `tfsdk:"shop_web_address"`
+	CaptureDelay              types.String `tfsdk:"capture_delay"`
+	DefaultShopperInteraction types.String `tfsdk:"default_shopper_interaction"`
+}
+
+// mapMerchantAccountModel maps a merchant account returned by the Adyen API to its Terraform model.
+func mapMerchantAccountModel(merchant management.Merchant) merchantAccountModel {
+	return merchantAccountModel{
+		ID:                        types.StringPointerValue(merchant.Id),
+		CompanyID:                 types.StringPointerValue(merchant.CompanyId),
+		Name:                      types.StringPointerValue(merchant.Name),
+		Description:               types.StringPointerValue(merchant.Description),
+		Reference:                 types.StringPointerValue(merchant.Reference),
+		Status:                    types.StringPointerValue(merchant.Status),
+		PricingPlan:               types.StringPointerValue(merchant.PricingPlan),
+		MerchantCity:              types.StringPointerValue(merchant.MerchantCity),
+		PrimarySettlementCurrency: types.StringPointerValue(merchant.PrimarySettlementCurrency),
+		ShopWebAddress:            types.StringPointerValue(merchant.ShopWebAddress),
+		CaptureDelay:              types.StringPointerValue(merchant.CaptureDelay),
+		DefaultShopperInteraction: types.StringPointerValue(merchant.DefaultShopperInteraction),
+	}
+}
+
+// merchantAccountDataSourceAttributes returns the schema attributes of a merchant account returned by a data source.
+func merchantAccountDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the merchant account.",
+		},
+		"company_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the company account the merchant account belongs to.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the legal entity associated with the merchant account.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Your description for the merchant account.",
+		},
+		"reference": schema.StringAttribute{
+			Computed:    true,
+			Description: "Your reference for the merchant account.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the merchant account: PreActive, Active, InactiveWithModifications, Inactive or Closed.",
+		},
+		"pricing_plan": schema.StringAttribute{
+			Computed:    true,
+			Description: "Only applies to merchant accounts managed by Adyen's partners. The name of the pricing plan assigned to the merchant account.",
+		},
+		"merchant_city": schema.StringAttribute{
+			Computed:    true,
+			Description: "The city where the legal entity of the merchant account is registered.",
+		},
+		"primary_settlement_currency": schema.StringAttribute{
+			Computed:    true,
+			Description: "The currency of the country where the legal entity of the merchant account is registered.",
+		},
+		"shop_web_address": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URL for the ecommerce website used with the merchant account.",
+		},
+		"capture_delay": schema.StringAttribute{
+			Computed:    true,
+			Description: "The capture delay set for the merchant account: Immediate, Manual or a number of days from 1 to 29.",
+		},
+		"default_shopper_interaction": schema.StringAttribute{
+			Computed:    true,
+			Description: "The default shopperInteraction value used when processing payments through the merchant account.",
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *merchantAccountsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *merchantAccountsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_merchant_accounts"
+}
+
+// Schema defines the schema for the data source.
+func (d *merchantAccountsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns all merchant accounts under a company account.\n\n" +
+			"To make this request, your API credential must have one of the following roles:\n\nManagement API—Accounts read\nManagement API—Accounts read and write",
+		Attributes: map[string]schema.Attribute{
+			"company_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the company account.",
+			},
+			"merchant_accounts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The merchant accounts of the company account.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: merchantAccountDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *merchantAccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state merchantAccountsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading merchant accounts data source...")
+
+	merchants, err := listCompanyMerchantAccounts(ctx, d.client, state.CompanyID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Merchant Accounts",
+			"Could not list merchant accounts of company "+state.CompanyID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.MerchantAccounts = []merchantAccountModel{}
+	for _, merchant := range merchants {
+		state.MerchantAccounts = append(state.MerchantAccounts, mapMerchantAccountModel(merchant))
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listCompanyMerchantAccounts returns all merchant accounts of a company account.
+func listCompanyMerchantAccounts(ctx context.Context, client *adyen.APIClient, companyID string) ([]management.Merchant, error) {
+	var merchants []management.Merchant
+
+	// Adyen returns at most 100 merchant accounts per page, so keep fetching until all pages are read.
+	for page := int32(1); ; page++ {
+		listMerchantAccountsInput := client.Management().AccountCompanyLevelApi.
+			ListMerchantAccountsInput(companyID).
+			PageNumber(page).
+			PageSize(listPageSize)
+		listMerchantResponse, _, err := client.Management().AccountCompanyLevelApi.ListMerchantAccounts(ctx, listMerchantAccountsInput)
+		if err != nil {
+			return nil, err
+		}
+
+		merchants = append(merchants, listMerchantResponse.Data...)
+
+		if page >= listMerchantResponse.PagesTotal {
+			return merchants, nil
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccMerchantAccountsDataSource(t *testing.T) {
+	dataSourceName := "data.adyen_merchant_accounts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + `
+	data "adyen_merchant_accounts" "test" {
+		company_id = "WeaveAccount"
+	}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "company_id", "WeaveAccount"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "merchant_accounts.*", map[string]string{
+						"id":         "WeaveAccountECOM",
+						"company_id": "WeaveAccount",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+// seedMockAccounts adds the company and merchant accounts the acceptance tests refer to, which exist up front in an Adyen test environment.
+func (m *mockManagementServer) seedMockAccounts() {
+	m.companies["WeaveAccount"] = &management.Company{
+		Id:     common.PtrString("WeaveAccount"),
+		Name:   common.PtrString("Weave"),
+		Status: common.PtrString("Active"),
+	}
+	for _, id := range []string{"WeaveAccountECOM", "WeaveAccountPOS"} {
+		m.merchants[id] = &management.Merchant{
+			Id:                        common.PtrString(id),
+			CompanyId:                 common.PtrString("WeaveAccount"),
+			Name:                      common.PtrString("Weave"),
+			MerchantCity:              common.PtrString("Amsterdam"),
+			PrimarySettlementCurrency: common.PtrString("EUR"),
+			Status:                    common.PtrString("Active"),
+		}
+	}
+}
+
+func (m *mockManagementServer) registerAccountRoutes() {
+	m.seedMockAccounts()
+
+	m.handle(http.MethodPost, "/merchants", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		m.createMerchantAccount(w, r)
+	})
+	m.handle(http.MethodGet, "/merchants/{merchantId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if merchant, ok := m.findMerchantAccount(w, params["merchantId"]); ok {
+			writeMockJSON(w, http.StatusOK, merchant)
+		}
+	})
+	m.handle(http.MethodPost, "/merchants/{merchantId}/activate", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if merchant, ok := m.findMerchantAccount(w, params["merchantId"]); ok {
+			// Adyen reviews activation requests; the mock approves them right away.
+			merchant.Status = common.PtrString("Active")
+			writeMockJSON(w, http.StatusOK, management.RequestActivationResponse{CompanyId: merchant.CompanyId, MerchantId: merchant.Id})
+		}
+	})
+	m.handle(http.MethodGet, "/companies", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		m.listCompanyAccounts(w)
+	})
+	m.handle(http.MethodGet, "/companies/{companyId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if company, ok := m.findCompanyAccount(w, params["companyId"]); ok {
+			writeMockJSON(w, http.StatusOK, company)
+		}
+	})
+	m.handle(http.MethodGet, "/companies/{companyId}/merchants", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if _, ok := m.findCompanyAccount(w, params["companyId"]); ok {
+			m.listCompanyMerchantAccounts(w, params["companyId"])
+		}
+	})
+}
+
+// findCompanyAccount looks up a company account, answering with a 422 if it does not exist.
+func (m *mockManagementServer) findCompanyAccount(w http.ResponseWriter, companyID string) (*management.Company, bool) {
+	company, ok := m.companies[companyID]
+	if !ok {
+		writeMockNotFound(w, "Company account", companyID)
+		return nil, false
+	}
+	return company, true
+}
+
+// findMerchantAccount looks up a merchant account, answering with a 422 if it does not exist.
+func (m *mockManagementServer) findMerchantAccount(w http.ResponseWriter, merchantID string) (*management.Merchant, bool) {
+	merchant, ok := m.merchants[merchantID]
+	if !ok {
+		writeMockNotFound(w, "Merchant account", merchantID)
+		return nil, false
+	}
+	return merchant, true
+}
+
+func (m *mockManagementServer) createMerchantAccount(w http.ResponseWriter, r *http.Request) {
+	var req management.CreateMerchantRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+	company, ok := m.findCompanyAccount(w, req.CompanyId)
+	if !ok {
+		return
+	}
+
+	// Without a reference template on the company account, Adyen generates the identifier.
+	id := m.nextID("MA")
+	merchant := &management.Merchant{
+		Id:          &id,
+		CompanyId:   company.Id,
+		Name:        company.Name,
+		Description: req.Description,
+		PricingPlan: req.PricingPlan,
+		Reference:   req.Reference,
+		Status:      common.PtrString("PreActive"),
+	}
+	if merchant.Reference == nil {
+		merchant.Reference = &id
+	}
+	m.merchants[id] = merchant
+
+	writeMockJSON(w, http.StatusOK, management.CreateMerchantResponse{
+		BusinessLineId: req.BusinessLineId,
+		CompanyId:      merchant.CompanyId,
+		Description:    merchant.Description,
+		Id:             merchant.Id,
+		LegalEntityId:  req.LegalEntityId,
+		PricingPlan:    merchant.PricingPlan,
+		Reference:      merchant.Reference,
+	})
+}
+
+func (m *mockManagementServer) listCompanyAccounts(w http.ResponseWriter) {
+	data := make([]management.Company, 0)
+	for _, company := range m.companies {
+		data = append(data, *company)
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i].GetId() < data[j].GetId() })
+
+	writeMockJSON(w, http.StatusOK, management.ListCompanyResponse{
+		Data:       data,
+		ItemsTotal: int32(len(data)),
+		PagesTotal: 1,
+	})
+}
+
+func (m *mockManagementServer) listCompanyMerchantAccounts(w http.ResponseWriter, companyID string) {
+	data := make([]management.Merchant, 0)
+	for _, merchant := range m.merchants {
+		if merchant.GetCompanyId() == companyID {
+			data = append(data, *merchant)
+		}
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i].GetId() < data[j].GetId() })
+
+	writeMockJSON(w, http.StatusOK, management.ListMerchantResponse{
+		Data:       data,
+		ItemsTotal: int32(len(data)),
+		PagesTotal: 1,
+	})
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+// mockAndroidApps are the Android apps uploaded for the company account the acceptance tests refer to.
+var mockAndroidApps = []management.AndroidApp{
+	{
+		Id:          "ANDA422LZ223223K5F694GCCF732K8",
+		PackageName: common.PtrString("com.weave.pos"),
+		Label:       common.PtrString("Weave POS"),
+		VersionCode: common.PtrInt32(1),
+		VersionName: common.PtrString("1.0.0"),
+		Description: common.PtrString("Weave point of sale"),
+		Status:      "archived",
+	},
+	{
+		Id:          "ANDA422LZ223223K5F694GCCF732K9",
+		PackageName: common.PtrString("com.weave.pos"),
+		Label:       common.PtrString("Weave POS"),
+		VersionCode: common.PtrInt32(2),
+		VersionName: common.PtrString("1.1.0"),
+		Description: common.PtrString("Weave point of sale"),
+		Status:      "ready",
+	},
+	{
+		Id:          "ANDA422LZ223223K5F694GCCF732KA",
+		PackageName: common.PtrString("com.weave.kiosk"),
+		Label:       common.PtrString("Weave Kiosk"),
+		VersionCode: common.PtrInt32(7),
+		VersionName: common.PtrString("0.7.0"),
+		Status:      "error",
+		ErrorCode:   common.PtrString("InvalidSignature"),
+	},
+}
+
+// mockAndroidCertificates are the Android certificates uploaded for the company account the acceptance tests refer to.
+var mockAndroidCertificates = []management.AndroidCertificate{
+	{
+		Id:          "ANDC422LZ223223K5F78NVN9SL4VPH",
+		Name:        common.PtrString("weave-root"),
+		Extension:   common.PtrString(".pem"),
+		Description: common.PtrString("Weave root certificate"),
+		Status:      common.PtrString("ready"),
+		NotBefore:   common.PtrTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		NotAfter:    common.PtrTime(time.Date(2034, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		Id:        "ANDC422LZ223223K5F78NVN9SL4VPJ",
+		Name:      common.PtrString("weave-wifi"),
+		Extension: common.PtrString(".crt"),
+		Status:    common.PtrString("archived"),
+		NotBefore: common.PtrTime(time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		NotAfter:  common.PtrTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func (m *mockManagementServer) registerAndroidFileRoutes() {
+	m.handle(http.MethodGet, "/companies/{companyId}/androidApps", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if _, ok := m.findCompanyAccount(w, params["companyId"]); !ok {
+			return
+		}
+
+		query := r.URL.Query()
+		apps := []management.AndroidApp{}
+		for _, app := range mockAndroidApps {
+			if packageName := query.Get("packageName"); packageName != "" && app.GetPackageName() != packageName {
+				continue
+			}
+			if versionCode := query.Get("versionCode"); versionCode != "" && strconv.Itoa(int(app.GetVersionCode())) != versionCode {
+				continue
+			}
+			apps = append(apps, app)
+		}
+		start, end := mockPage(r, len(apps))
+		writeMockJSON(w, http.StatusOK, management.AndroidAppsResponse{Data: apps[start:end]})
+	})
+	m.handle(http.MethodGet, "/companies/{companyId}/androidCertificates", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if _, ok := m.findCompanyAccount(w, params["companyId"]); !ok {
+			return
+		}
+
+		certificates := []management.AndroidCertificate{}
+		for _, certificate := range mockAndroidCertificates {
+			if name := r.URL.Query().Get("certificateName"); name != "" && certificate.GetName() != name {
+				continue
+			}
+			certificates = append(certificates, certificate)
+		}
+		start, end := mockPage(r, len(certificates))
+		writeMockJSON(w, http.StatusOK, management.AndroidCertificatesResponse{Data: certificates[start:end]})
+	})
+}
+
+// mockPage returns the bounds of the page requested with the pageNumber and pageSize query parameters.
+func mockPage(r *http.Request, total int) (int, int) {
+	pageNumber, err := strconv.Atoi(r.URL.Query().Get("pageNumber"))
+	if err != nil || pageNumber < 1 {
+		pageNumber = 1
+	}
+	pageSize := mockPageSize(r)
+
+	start := min((pageNumber-1)*pageSize, total)
+	return start, min(start+pageSize, total)
+}
+
+// mockPageSize returns the page size requested with the pageSize query parameter, which is 20 by default like in Adyen.
+func mockPageSize(r *http.Request) int {
+	pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if err != nil || pageSize < 1 {
+		return 20
+	}
+	return pageSize
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+// mockApiCredential is an API credential stored by the mock server, together with the account it belongs to.
+// The company API credential is a superset of the merchant API credential, so it is used for both.
+type mockApiCredential struct {
+	level      string // "merchants" or "companies"
+	accountID  string
+	credential management.CompanyApiCredential
+}
+
+// mockDefaultApiCredentialRoles are the roles Adyen assigns to a new API credential when none are requested.
+var mockDefaultApiCredentialRoles = []string{"Checkout webservice role", "Merchant PAL webservice role"}
+
+// mockMeApiCredential is the API credential the mock server answers with for the API key of the provider.
+var mockMeApiCredential = management.MeApiCredential{
+	Id:                 "S2-00000000000000000000",
+	Username:           "ws@Company.WeaveAccount",
+	Description:        common.PtrString("Terraform provider"),
+	CompanyName:        common.PtrString("WeaveAccount"),
+	Roles:              []string{"Management API - Accounts read", "Management API - API credentials read and write", "Management API - Users read and write", "Management API - Webhooks read and write"},
+	AllowedOrigins:     []management.AllowedOrigin{{Domain: "https://www.example.com", Id: common.PtrString("AO00000000000000000000")}},
+	AllowedIpAddresses: []string{},
+	ClientKey:          "test_CK00000000000000000000",
+	Active:             true,
+}
+
+func (m *mockManagementServer) registerApiCredentialRoutes() {
+	m.handle(http.MethodGet, "/me", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		writeMockJSON(w, http.StatusOK, mockMeApiCredential)
+	})
+	for _, level := range []string{"merchants", "companies"} {
+		level := level
+		m.handle(http.MethodPost, "/"+level+"/{accountId}/apiCredentials", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			m.createApiCredential(w, r, level, params["accountId"])
+		})
+		m.handle(http.MethodGet, "/"+level+"/{accountId}/apiCredentials", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			m.listApiCredentials(w, level, params["accountId"])
+		})
+		m.handle(http.MethodGet, "/"+level+"/{accountId}/apiCredentials/{apiCredentialId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findApiCredential(w, level, params["accountId"], params["apiCredentialId"]); ok {
+				writeMockJSON(w, http.StatusOK, stored.credential)
+			}
+		})
+		m.handle(http.MethodPatch, "/"+level+"/{accountId}/apiCredentials/{apiCredentialId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findApiCredential(w, level, params["accountId"], params["apiCredentialId"]); ok {
+				m.updateApiCredential(w, r, stored)
+			}
+		})
+		m.handle(http.MethodPost, "/"+level+"/{accountId}/apiCredentials/{apiCredentialId}/generateApiKey", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if _, ok := m.findApiCredential(w, level, params["accountId"], params["apiCredentialId"]); ok {
+				writeMockJSON(w, http.StatusOK, management.GenerateApiKeyResponse{ApiKey: m.nextID("AQE")})
+			}
+		})
+		m.handle(http.MethodPost, "/"+level+"/{accountId}/apiCredentials/{apiCredentialId}/generateClientKey", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findApiCredential(w, level, params["accountId"], params["apiCredentialId"]); ok {
+				stored.credential.ClientKey = "test_" + m.nextID("CK")
+				writeMockJSON(w, http.StatusOK, management.GenerateClientKeyResponse{ClientKey: stored.credential.ClientKey})
+			}
+		})
+		m.handle(http.MethodPost, "/"+level+"/{accountId}/apiCredentials/{apiCredentialId}/allowedOrigins", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findApiCredential(w, level, params["accountId"], params["apiCredentialId"]); ok {
+				m.createAllowedOrigin(w, r, stored)
+			}
+		})
+		m.handle(http.MethodGet, "/"+level+"/{accountId}/apiCredentials/{apiCredentialId}/allowedOrigins", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findApiCredential(w, level, params["accountId"], params["apiCredentialId"]); ok {
+				writeMockJSON(w, http.StatusOK, management.AllowedOriginsResponse{Data: stored.credential.AllowedOrigins})
+			}
+		})
+		m.handle(http.MethodGet, "/"+level+"/{accountId}/apiCredentials/{apiCredentialId}/allowedOrigins/{originId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findApiCredential(w, level, params["accountId"], params["apiCredentialId"]); ok {
+				for _, allowedOrigin := range stored.credential.AllowedOrigins {
+					if allowedOrigin.GetId() == params["originId"] {
+						writeMockJSON(w, http.StatusOK, allowedOrigin)
+						return
+					}
+				}
+				writeMockNotFound(w, "Allowed origin", params["originId"])
+			}
+		})
+		m.handle(http.MethodDelete, "/"+level+"/{accountId}/apiCredentials/{apiCredentialId}/allowedOrigins/{originId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findApiCredential(w, level, params["accountId"], params["apiCredentialId"]); ok {
+				m.deleteAllowedOrigin(w, stored, params["originId"])
+			}
+		})
+	}
+}
+
+// findApiCredential looks up an API credential of the given account, answering with a 422 if it does not exist.
+func (m *mockManagementServer) findApiCredential(w http.ResponseWriter, level string, accountID string, apiCredentialID string) (*mockApiCredential, bool) {
+	stored, ok := m.apiCredentials[apiCredentialID]
+	if !ok || stored.level != level || stored.accountID != accountID {
+		writeMockNotFound(w, "API credential", apiCredentialID)
+		return nil, false
+	}
+	return stored, true
+}
+
+// mockAllowedOrigins maps domains to allowed origins, keeping the identifiers of the domains that were already allowed.
+func (m *mockManagementServer) mockAllowedOrigins(domains []string, existing []management.AllowedOrigin) []management.AllowedOrigin {
+	allowedOrigins := make([]management.AllowedOrigin, 0, len(domains))
+	for _, domain := range domains {
+		allowedOrigin := management.AllowedOrigin{Domain: domain}
+		for _, e := range existing {
+			if e.Domain == domain {
+				allowedOrigin.Id = e.Id
+			}
+		}
+		if allowedOrigin.Id == nil {
+			id := m.nextID("AO")
+			allowedOrigin.Id = &id
+		}
+		allowedOrigins = append(allowedOrigins, allowedOrigin)
+	}
+	return allowedOrigins
+}
+
+func (m *mockManagementServer) createAllowedOrigin(w http.ResponseWriter, r *http.Request, stored *mockApiCredential) {
+	var req management.AllowedOrigin
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	for _, allowedOrigin := range stored.credential.AllowedOrigins {
+		if allowedOrigin.Domain == req.Domain {
+			writeMockError(w, http.StatusUnprocessableEntity, "000_422", "Unprocessable Entity", "Allowed origin "+req.Domain+" already exists.")
+			return
+		}
+	}
+
+	allowedOrigins := m.mockAllowedOrigins([]string{req.Domain}, nil)
+	stored.credential.AllowedOrigins = append(stored.credential.AllowedOrigins, allowedOrigins...)
+	writeMockJSON(w, http.StatusOK, allowedOrigins[0])
+}
+
+func (m *mockManagementServer) deleteAllowedOrigin(w http.ResponseWriter, stored *mockApiCredential, originID string) {
+	for i, allowedOrigin := range stored.credential.AllowedOrigins {
+		if allowedOrigin.GetId() == originID {
+			stored.credential.AllowedOrigins = append(stored.credential.AllowedOrigins[:i], stored.credential.AllowedOrigins[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeMockNotFound(w, "Allowed origin", originID)
+}
+
+func (m *mockManagementServer) createApiCredential(w http.ResponseWriter, r *http.Request, level string, accountID string) {
+	// The company request is a superset of the merchant request, so it can be used to decode both.
+	var req management.CreateCompanyApiCredentialRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	id := m.nextID("S2-")
+	accountType := "Merchant"
+	if level == "companies" {
+		accountType = "Company"
+	}
+	credential := management.CompanyApiCredential{
+		Id:                 id,
+		Username:           "ws_" + strings.TrimPrefix(id, "S2-") + "@" + accountType + "." + accountID,
+		Description:        req.Description,
+		Roles:              req.Roles,
+		AllowedOrigins:     m.mockAllowedOrigins(req.AllowedOrigins, nil),
+		AllowedIpAddresses: []string{},
+		ClientKey:          "test_" + m.nextID("CK"),
+		Active:             true,
+	}
+	if len(credential.Roles) == 0 {
+		credential.Roles = mockDefaultApiCredentialRoles
+	}
+	if level == "companies" {
+		credential.AssociatedMerchantAccounts = req.AssociatedMerchantAccounts
+	}
+	m.apiCredentials[id] = &mockApiCredential{level: level, accountID: accountID, credential: credential}
+
+	writeMockJSON(w, http.StatusOK, management.CreateCompanyApiCredentialResponse{
+		Active:                     credential.Active,
+		AllowedIpAddresses:         credential.AllowedIpAddresses,
+		AllowedOrigins:             credential.AllowedOrigins,
+		ApiKey:                     m.nextID("AQE"),
+		AssociatedMerchantAccounts: credential.AssociatedMerchantAccounts,
+		ClientKey:                  credential.ClientKey,
+		Description:                credential.Description,
+		Id:                         credential.Id,
+		Password:                   m.nextID("PW"),
+		Roles:                      credential.Roles,
+		Username:                   credential.Username,
+	})
+}
+
+func (m *mockManagementServer) updateApiCredential(w http.ResponseWriter, r *http.Request, stored *mockApiCredential) {
+	// The company request is a superset of the merchant request, so it can be used to decode both.
+	var req management.UpdateCompanyApiCredentialRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	credential := &stored.credential
+	if req.Description != nil {
+		credential.Description = req.Description
+	}
+	if req.Roles != nil {
+		credential.Roles = req.Roles
+	}
+	if req.AllowedOrigins != nil {
+		credential.AllowedOrigins = m.mockAllowedOrigins(req.AllowedOrigins, credential.AllowedOrigins)
+	}
+	if req.Active != nil {
+		credential.Active = *req.Active
+	}
+	if stored.level == "companies" && req.AssociatedMerchantAccounts != nil {
+		credential.AssociatedMerchantAccounts = req.AssociatedMerchantAccounts
+	}
+
+	writeMockJSON(w, http.StatusOK, credential)
+}
+
+func (m *mockManagementServer) listApiCredentials(w http.ResponseWriter, level string, accountID string) {
+	data := make([]management.CompanyApiCredential, 0)
+	for _, stored := range m.apiCredentials {
+		if stored.level == level && stored.accountID == accountID {
+			data = append(data, stored.credential)
+		}
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i].Id < data[j].Id })
+
+	writeMockJSON(w, http.StatusOK, management.ListCompanyApiCredentialsResponse{
+		Data:       data,
+		ItemsTotal: int32(len(data)),
+		PagesTotal: 1,
+	})
+}
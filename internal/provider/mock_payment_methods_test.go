@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+// mockPaymentMethod is a payment method stored by the mock server, together with the merchant account it belongs to.
+type mockPaymentMethod struct {
+	merchantID    string
+	paymentMethod management.PaymentMethod
+}
+
+// The references of payment methods the mock server rejects, or keeps pending approval.
+const (
+	mockPaymentMethodRejectedReference = "TerraformRejected"
+	mockPaymentMethodPendingReference  = "TerraformPending"
+)
+
+func (m *mockManagementServer) registerPaymentMethodRoutes() {
+	m.handle(http.MethodPost, "/merchants/{merchantId}/paymentMethodSettings", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if _, ok := m.findMerchantAccount(w, params["merchantId"]); ok {
+			m.createPaymentMethod(w, r, params["merchantId"])
+		}
+	})
+	m.handle(http.MethodGet, "/merchants/{merchantId}/paymentMethodSettings/{paymentMethodId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if stored, ok := m.findPaymentMethod(w, params["merchantId"], params["paymentMethodId"]); ok {
+			// Adyen reviews payment methods that are pending; the mock approves them the first time they are looked up,
+			// unless their reference asks for a rejection or for a review that never ends.
+			if stored.paymentMethod.GetVerificationStatus() == "pending" {
+				switch stored.paymentMethod.GetReference() {
+				case mockPaymentMethodRejectedReference:
+					stored.paymentMethod.VerificationStatus = common.PtrString("rejected")
+				case mockPaymentMethodPendingReference:
+				default:
+					stored.paymentMethod.VerificationStatus = common.PtrString("valid")
+					stored.paymentMethod.Allowed = common.PtrBool(true)
+				}
+			}
+			writeMockJSON(w, http.StatusOK, stored.paymentMethod)
+		}
+	})
+	m.handle(http.MethodPatch, "/merchants/{merchantId}/paymentMethodSettings/{paymentMethodId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if stored, ok := m.findPaymentMethod(w, params["merchantId"], params["paymentMethodId"]); ok {
+			m.updatePaymentMethod(w, r, stored)
+		}
+	})
+	m.handle(http.MethodPost, "/merchants/{merchantId}/paymentMethodSettings/{paymentMethodId}/addApplePayDomains", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if stored, ok := m.findApplePayPaymentMethod(w, params["merchantId"], params["paymentMethodId"]); ok {
+			m.addApplePayDomains(w, r, stored)
+		}
+	})
+	m.handle(http.MethodGet, "/merchants/{merchantId}/paymentMethodSettings/{paymentMethodId}/getApplePayDomains", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if stored, ok := m.findApplePayPaymentMethod(w, params["merchantId"], params["paymentMethodId"]); ok {
+			writeMockJSON(w, http.StatusOK, stored.paymentMethod.ApplePay)
+		}
+	})
+}
+
+// findApplePayPaymentMethod looks up an Apple Pay payment method, answering with a 422 if it does not exist or is of another type.
+func (m *mockManagementServer) findApplePayPaymentMethod(w http.ResponseWriter, merchantID string, paymentMethodID string) (*mockPaymentMethod, bool) {
+	stored, ok := m.findPaymentMethod(w, merchantID, paymentMethodID)
+	if !ok {
+		return nil, false
+	}
+	if stored.paymentMethod.GetType() != "applepay" {
+		writeMockError(w, http.StatusUnprocessableEntity, "000_422", "Unprocessable Entity", "Payment method "+paymentMethodID+" is not an Apple Pay payment method.")
+		return nil, false
+	}
+	if stored.paymentMethod.ApplePay == nil {
+		stored.paymentMethod.ApplePay = &management.ApplePayInfo{Domains: []string{}}
+	}
+	return stored, true
+}
+
+func (m *mockManagementServer) addApplePayDomains(w http.ResponseWriter, r *http.Request, stored *mockPaymentMethod) {
+	var req management.ApplePayInfo
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	applePay := stored.paymentMethod.ApplePay
+	for _, domain := range req.Domains {
+		if !slices.Contains(applePay.Domains, domain) {
+			applePay.Domains = append(applePay.Domains, domain)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findPaymentMethod looks up a payment method of the given merchant account, answering with a 422 if it does not exist.
+func (m *mockManagementServer) findPaymentMethod(w http.ResponseWriter, merchantID string, paymentMethodID string) (*mockPaymentMethod, bool) {
+	stored, ok := m.paymentMethods[paymentMethodID]
+	if !ok || stored.merchantID != merchantID {
+		writeMockNotFound(w, "Payment method", paymentMethodID)
+		return nil, false
+	}
+	return stored, true
+}
+
+func (m *mockManagementServer) createPaymentMethod(w http.ResponseWriter, r *http.Request, merchantID string) {
+	var req management.PaymentMethodSetupInfo
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	paymentMethod := management.PaymentMethod{
+		Id:                 m.nextID("PM"),
+		Type:               &req.Type,
+		BusinessLineId:     req.BusinessLineId,
+		Reference:          req.Reference,
+		ShopperInteraction: req.ShopperInteraction,
+		StoreIds:           req.StoreIds,
+		Countries:          req.Countries,
+		Currencies:         req.Currencies,
+		CustomRoutingFlags: req.CustomRoutingFlags,
+		Enabled:            common.PtrBool(true),
+		Allowed:            common.PtrBool(false),
+		VerificationStatus: common.PtrString("pending"),
+		ApplePay:           req.ApplePay,
+		GooglePay:          req.GooglePay,
+		Paypal:             req.Paypal,
+		Klarna:             req.Klarna,
+		Swish:              req.Swish,
+		Sofort:             req.Sofort,
+		Bcmc:               req.Bcmc,
+	}
+	// Without a sales channel, Adyen uses the one of the merchant account.
+	if paymentMethod.ShopperInteraction == nil {
+		paymentMethod.ShopperInteraction = common.PtrString("eCommerce")
+	}
+	m.paymentMethods[paymentMethod.Id] = &mockPaymentMethod{merchantID: merchantID, paymentMethod: paymentMethod}
+
+	writeMockJSON(w, http.StatusOK, paymentMethod)
+}
+
+func (m *mockManagementServer) updatePaymentMethod(w http.ResponseWriter, r *http.Request, stored *mockPaymentMethod) {
+	var req management.UpdatePaymentMethodInfo
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	paymentMethod := &stored.paymentMethod
+	if req.Enabled != nil {
+		paymentMethod.Enabled = req.Enabled
+	}
+	if req.StoreIds != nil {
+		paymentMethod.StoreIds = req.StoreIds
+	}
+	if req.Countries != nil {
+		paymentMethod.Countries = req.Countries
+	}
+	if req.Currencies != nil {
+		paymentMethod.Currencies = req.Currencies
+	}
+	if req.CustomRoutingFlags != nil {
+		paymentMethod.CustomRoutingFlags = req.CustomRoutingFlags
+	}
+	if req.Bcmc != nil {
+		paymentMethod.Bcmc = req.Bcmc
+	}
+
+	writeMockJSON(w, http.StatusOK, paymentMethod)
+}
+
+// changePaymentMethod changes a stored payment method, to simulate a change made in the Customer Area.
+func (m *mockManagementServer) changePaymentMethod(paymentMethodID string, change func(paymentMethod *management.PaymentMethod)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	change(&m.paymentMethods[paymentMethodID].paymentMethod)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+// mockPayoutSetting is a payout setting stored by the mock server, together with the merchant account it belongs to.
+type mockPayoutSetting struct {
+	merchantID     string
+	payoutSettings management.PayoutSettings
+}
+
+func (m *mockManagementServer) registerPayoutSettingRoutes() {
+	m.handle(http.MethodPost, "/merchants/{merchantId}/payoutSettings", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if _, ok := m.findMerchantAccount(w, params["merchantId"]); ok {
+			m.createPayoutSetting(w, r, params["merchantId"])
+		}
+	})
+	m.handle(http.MethodGet, "/merchants/{merchantId}/payoutSettings/{payoutSettingsId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if stored, ok := m.findPayoutSetting(w, params["merchantId"], params["payoutSettingsId"]); ok {
+			writeMockJSON(w, http.StatusOK, stored.payoutSettings)
+		}
+	})
+	m.handle(http.MethodPatch, "/merchants/{merchantId}/payoutSettings/{payoutSettingsId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if stored, ok := m.findPayoutSetting(w, params["merchantId"], params["payoutSettingsId"]); ok {
+			var req management.UpdatePayoutSettingsRequest
+			if !decodeMockRequest(w, r, &req) {
+				return
+			}
+			if req.Enabled != nil {
+				stored.payoutSettings.Enabled = req.Enabled
+			}
+			writeMockJSON(w, http.StatusOK, stored.payoutSettings)
+		}
+	})
+	m.handle(http.MethodDelete, "/merchants/{merchantId}/payoutSettings/{payoutSettingsId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if _, ok := m.findPayoutSetting(w, params["merchantId"], params["payoutSettingsId"]); ok {
+			delete(m.payoutSettings, params["payoutSettingsId"])
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+}
+
+// findPayoutSetting looks up a payout setting of the given merchant account, answering with a 422 if it does not exist.
+func (m *mockManagementServer) findPayoutSetting(w http.ResponseWriter, merchantID string, payoutSettingsID string) (*mockPayoutSetting, bool) {
+	stored, ok := m.payoutSettings[payoutSettingsID]
+	if !ok || stored.merchantID != merchantID {
+		writeMockNotFound(w, "Payout setting", payoutSettingsID)
+		return nil, false
+	}
+	return stored, true
+}
+
+func (m *mockManagementServer) createPayoutSetting(w http.ResponseWriter, r *http.Request, merchantID string) {
+	var req management.PayoutSettingsRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	// Adyen verifies the bank account after the payout setting is added, so it starts out pending.
+	payoutSettings := management.PayoutSettings{
+		Id:                   m.nextID("PYST"),
+		TransferInstrumentId: req.TransferInstrumentId,
+		Enabled:              req.Enabled,
+		EnabledFromDate:      req.EnabledFromDate,
+		Allowed:              common.PtrBool(false),
+		Priority:             common.PtrString("normal"),
+		VerificationStatus:   common.PtrString("pending"),
+	}
+	if payoutSettings.Enabled == nil {
+		payoutSettings.Enabled = common.PtrBool(true)
+	}
+	m.payoutSettings[payoutSettings.Id] = &mockPayoutSetting{merchantID: merchantID, payoutSettings: payoutSettings}
+
+	writeMockJSON(w, http.StatusOK, payoutSettings)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+// mockManagementServer is an in-process fake of the Adyen Management API, used to run the acceptance tests
+// without a live Adyen test account. It keeps its state in memory and answers with the same status codes
+// as Adyen does, e.g. a 422 Unprocessable Entity when a resource does not exist.
+type mockManagementServer struct {
+	server *httptest.Server
+	routes []mockRoute
+
+	mu               sync.Mutex
+	sequence         int
+	webhooks         map[string]*mockWebhook
+	users            map[string]*mockUser
+	apiCredentials   map[string]*mockApiCredential
+	companies        map[string]*management.Company
+	merchants        map[string]*management.Merchant
+	stores           map[string]*management.Store
+	paymentMethods   map[string]*mockPaymentMethod
+	payoutSettings   map[string]*mockPayoutSetting
+	terminalSettings map[string]*management.TerminalSettings
+	terminalLogos    map[string]*string
+	terminalOrders   map[string]*mockTerminalOrder
+	terminals        map[string]*management.Terminal
+	terminalActions  map[string]*mockTerminalAction
+}
+
+// mockRoute maps a method and a path pattern (e.g. "/companies/{companyId}/webhooks") to a handler.
+type mockRoute struct {
+	method   string
+	segments []string
+	handler  func(w http.ResponseWriter, r *http.Request, params map[string]string)
+}
+
+func newMockManagementServer() *mockManagementServer {
+	m := &mockManagementServer{
+		webhooks:         make(map[string]*mockWebhook),
+		users:            make(map[string]*mockUser),
+		apiCredentials:   make(map[string]*mockApiCredential),
+		companies:        make(map[string]*management.Company),
+		merchants:        make(map[string]*management.Merchant),
+		stores:           make(map[string]*management.Store),
+		paymentMethods:   make(map[string]*mockPaymentMethod),
+		payoutSettings:   make(map[string]*mockPayoutSetting),
+		terminalSettings: make(map[string]*management.TerminalSettings),
+		terminalLogos:    make(map[string]*string),
+		terminalOrders:   make(map[string]*mockTerminalOrder),
+		terminals:        make(map[string]*management.Terminal),
+		terminalActions:  make(map[string]*mockTerminalAction),
+	}
+	m.registerWebhookRoutes()
+	m.registerUserRoutes()
+	m.registerApiCredentialRoutes()
+	m.registerAccountRoutes()
+	m.registerStoreRoutes()
+	m.registerPaymentMethodRoutes()
+	m.registerPayoutSettingRoutes()
+	m.registerTerminalSettingsRoutes()
+	m.registerTerminalOrderRoutes()
+	m.registerTerminalRoutes()
+	m.registerAndroidFileRoutes()
+
+	m.server = httptest.NewServer(http.HandlerFunc(m.serveHTTP))
+	return m
+}
+
+// URL returns the base URL of the mock server, to be used as the provider "base_url".
+func (m *mockManagementServer) URL() string {
+	return m.server.URL
+}
+
+// Close shuts down the mock server.
+func (m *mockManagementServer) Close() {
+	m.server.Close()
+}
+
+func (m *mockManagementServer) handle(method string, pattern string, handler func(w http.ResponseWriter, r *http.Request, params map[string]string)) {
+	m.routes = append(m.routes, mockRoute{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+func (m *mockManagementServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Api-Key") == "" {
+		writeMockError(w, http.StatusUnauthorized, "000_401", "Unauthorized", "Missing API key.")
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	for _, route := range m.routes {
+		params, ok := route.match(r.Method, segments)
+		if !ok {
+			continue
+		}
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		route.handler(w, r, params)
+		return
+	}
+
+	writeMockError(w, http.StatusNotFound, "000_404", "Not Found", fmt.Sprintf("No mock route for %s %s.", r.Method, r.URL.Path))
+}
+
+func (route mockRoute) match(method string, segments []string) (map[string]string, bool) {
+	if route.method != method || len(route.segments) != len(segments) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, segment := range route.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[strings.Trim(segment, "{}")] = segments[i]
+			continue
+		}
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// nextID returns a new unique identifier with the given prefix, similar to the ones generated by Adyen.
+func (m *mockManagementServer) nextID(prefix string) string {
+	m.sequence++
+	return fmt.Sprintf("%s%08d", prefix, m.sequence)
+}
+
+// href returns an absolute link to the given path on the mock server.
+func (m *mockManagementServer) href(format string, args ...any) *string {
+	href := m.server.URL + fmt.Sprintf(format, args...)
+	return &href
+}
+
+func writeMockJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeMockError(w http.ResponseWriter, status int, errorCode string, title string, detail string) {
+	writeMockJSON(w, status, common.RestServiceError{
+		Type:      "https://docs.adyen.com/errors/general",
+		ErrorCode: errorCode,
+		Title:     title,
+		Detail:    detail,
+		Status:    int32(status),
+	})
+}
+
+// writeMockNotFound mirrors how Adyen answers requests for unknown resources: with a 422 Unprocessable Entity.
+func writeMockNotFound(w http.ResponseWriter, kind string, id string) {
+	writeMockError(w, http.StatusUnprocessableEntity, "000_422", "Unprocessable Entity", fmt.Sprintf("%s with id %s not found.", kind, id))
+}
+
+// decodeMockRequest decodes the JSON request body into v, answering with a 400 Bad Request on failure.
+func decodeMockRequest(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeMockError(w, http.StatusBadRequest, "000_400", "Bad Request", err.Error())
+		return false
+	}
+	return true
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+func (m *mockManagementServer) registerStoreRoutes() {
+	m.handle(http.MethodPost, "/stores", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		var req management.StoreCreationWithMerchantCodeRequest
+		if !decodeMockRequest(w, r, &req) {
+			return
+		}
+		if _, ok := m.findMerchantAccount(w, req.MerchantId); ok {
+			m.createStore(w, req.MerchantId, management.StoreCreationRequest{
+				Address:             req.Address,
+				BusinessLineIds:     req.BusinessLineIds,
+				Description:         req.Description,
+				ExternalReferenceId: req.ExternalReferenceId,
+				PhoneNumber:         req.PhoneNumber,
+				Reference:           req.Reference,
+				ShopperStatement:    req.ShopperStatement,
+				SplitConfiguration:  req.SplitConfiguration,
+			})
+		}
+	})
+	m.handle(http.MethodPost, "/merchants/{merchantId}/stores", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		var req management.StoreCreationRequest
+		if !decodeMockRequest(w, r, &req) {
+			return
+		}
+		if _, ok := m.findMerchantAccount(w, params["merchantId"]); ok {
+			m.createStore(w, params["merchantId"], req)
+		}
+	})
+	m.handle(http.MethodGet, "/stores", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		m.listStores(w, r.URL.Query().Get("merchantId"), r.URL.Query().Get("reference"))
+	})
+	m.handle(http.MethodGet, "/stores/{storeId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if store, ok := m.findStore(w, params["storeId"]); ok {
+			writeMockJSON(w, http.StatusOK, store)
+		}
+	})
+	m.handle(http.MethodPatch, "/stores/{storeId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if store, ok := m.findStore(w, params["storeId"]); ok {
+			m.updateStore(w, r, store)
+		}
+	})
+}
+
+// findStore looks up a store, answering with a 422 if it does not exist.
+func (m *mockManagementServer) findStore(w http.ResponseWriter, storeID string) (*management.Store, bool) {
+	store, ok := m.stores[storeID]
+	if !ok {
+		writeMockNotFound(w, "Store", storeID)
+		return nil, false
+	}
+	return store, true
+}
+
+func (m *mockManagementServer) createStore(w http.ResponseWriter, merchantID string, req management.StoreCreationRequest) {
+	if req.Reference != nil {
+		for _, store := range m.stores {
+			if store.GetMerchantId() == merchantID && store.GetReference() == *req.Reference {
+				writeMockError(w, http.StatusUnprocessableEntity, "000_422", "Unprocessable Entity", "Store reference "+*req.Reference+" already exists.")
+				return
+			}
+		}
+	}
+
+	id := m.nextID("ST")
+	store := &management.Store{
+		Id:                  &id,
+		MerchantId:          &merchantID,
+		Address:             &req.Address,
+		BusinessLineIds:     req.BusinessLineIds,
+		Description:         &req.Description,
+		ExternalReferenceId: req.ExternalReferenceId,
+		PhoneNumber:         &req.PhoneNumber,
+		Reference:           req.Reference,
+		ShopperStatement:    &req.ShopperStatement,
+		SplitConfiguration:  req.SplitConfiguration,
+		Status:              common.PtrString("active"),
+	}
+	// Without a reference, Adyen generates one.
+	if store.Reference == nil {
+		store.Reference = &id
+	}
+	if store.BusinessLineIds == nil {
+		store.BusinessLineIds = []string{}
+	}
+	m.stores[id] = store
+
+	writeMockJSON(w, http.StatusOK, store)
+}
+
+func (m *mockManagementServer) updateStore(w http.ResponseWriter, r *http.Request, store *management.Store) {
+	var req management.UpdateStoreRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+	if store.GetStatus() == "closed" {
+		writeMockError(w, http.StatusUnprocessableEntity, "000_422", "Unprocessable Entity", "Store "+store.GetId()+" is closed.")
+		return
+	}
+
+	// Like Adyen, an update only changes the fields in the request, and an empty string clears a field.
+	patch := func(target **string, value *string) {
+		switch {
+		case value == nil:
+		case *value == "":
+			*target = nil
+		default:
+			*target = value
+		}
+	}
+	if req.Address != nil {
+		patch(&store.Address.Line1, req.Address.Line1)
+		patch(&store.Address.Line2, req.Address.Line2)
+		patch(&store.Address.Line3, req.Address.Line3)
+		patch(&store.Address.City, req.Address.City)
+		patch(&store.Address.PostalCode, req.Address.PostalCode)
+		patch(&store.Address.StateOrProvince, req.Address.StateOrProvince)
+	}
+	if req.BusinessLineIds != nil {
+		store.BusinessLineIds = req.BusinessLineIds
+	}
+	if req.Description != nil {
+		store.Description = req.Description
+	}
+	if req.ExternalReferenceId != nil {
+		store.ExternalReferenceId = req.ExternalReferenceId
+	}
+	if req.PhoneNumber != nil {
+		store.PhoneNumber = req.PhoneNumber
+	}
+	if req.SplitConfiguration != nil {
+		if store.SplitConfiguration == nil {
+			store.SplitConfiguration = &management.StoreSplitConfiguration{}
+		}
+		patch(&store.SplitConfiguration.BalanceAccountId, req.SplitConfiguration.BalanceAccountId)
+		patch(&store.SplitConfiguration.SplitConfigurationId, req.SplitConfiguration.SplitConfigurationId)
+		if store.SplitConfiguration.BalanceAccountId == nil && store.SplitConfiguration.SplitConfigurationId == nil {
+			store.SplitConfiguration = nil
+		}
+	}
+	if req.Status != nil {
+		store.Status = req.Status
+	}
+
+	writeMockJSON(w, http.StatusOK, store)
+}
+
+func (m *mockManagementServer) listStores(w http.ResponseWriter, merchantID string, reference string) {
+	data := make([]management.Store, 0)
+	for _, store := range m.stores {
+		if (merchantID == "" || store.GetMerchantId() == merchantID) && (reference == "" || store.GetReference() == reference) {
+			data = append(data, *store)
+		}
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i].GetId() < data[j].GetId() })
+
+	writeMockJSON(w, http.StatusOK, management.ListStoresResponse{
+		Data:       data,
+		ItemsTotal: int32(len(data)),
+		PagesTotal: 1,
+	})
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+// mockTerminalProducts are the terminal products that can be ordered from the mock server, by identifier.
+var mockTerminalProducts = map[string]string{
+	"TBOX-V400m-684-EU": "V400m Package",
+	"TBOX-S1F2-EU":      "S1F2 Package",
+}
+
+// mockTerminalOrder is a terminal order stored by the mock server, together with the account it belongs to.
+type mockTerminalOrder struct {
+	level     string // "merchants" or "companies"
+	accountID string
+	order     management.TerminalOrder
+}
+
+func (m *mockManagementServer) registerTerminalOrderRoutes() {
+	for _, level := range []string{"merchants", "companies"} {
+		level := level
+		m.handle(http.MethodPost, "/"+level+"/{accountId}/terminalOrders", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if m.findTerminalOrderAccount(w, level, params["accountId"]) {
+				m.createTerminalOrder(w, r, level, params["accountId"])
+			}
+		})
+		m.handle(http.MethodGet, "/"+level+"/{accountId}/terminalOrders/{orderId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findTerminalOrder(w, level, params["accountId"], params["orderId"]); ok {
+				writeMockJSON(w, http.StatusOK, stored.order)
+			}
+		})
+		m.handle(http.MethodPatch, "/"+level+"/{accountId}/terminalOrders/{orderId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findPlacedTerminalOrder(w, level, params["accountId"], params["orderId"]); ok {
+				var req management.TerminalOrderRequest
+				if !decodeMockRequest(w, r, &req) {
+					return
+				}
+				mapMockTerminalOrderRequest(&stored.order, req)
+				writeMockJSON(w, http.StatusOK, stored.order)
+			}
+		})
+		m.handle(http.MethodPost, "/"+level+"/{accountId}/terminalOrders/{orderId}/cancel", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findPlacedTerminalOrder(w, level, params["accountId"], params["orderId"]); ok {
+				stored.order.Status = common.PtrString("Cancelled")
+				writeMockJSON(w, http.StatusOK, stored.order)
+			}
+		})
+	}
+}
+
+// findTerminalOrderAccount checks that the company or merchant account of a terminal order exists, answering with a 422 if it does not.
+func (m *mockManagementServer) findTerminalOrderAccount(w http.ResponseWriter, level string, accountID string) bool {
+	if level == "companies" {
+		_, ok := m.findCompanyAccount(w, accountID)
+		return ok
+	}
+	_, ok := m.findMerchantAccount(w, accountID)
+	return ok
+}
+
+// findTerminalOrder looks up a terminal order of the given account, answering with a 422 if it does not exist.
+func (m *mockManagementServer) findTerminalOrder(w http.ResponseWriter, level string, accountID string, orderID string) (*mockTerminalOrder, bool) {
+	stored, ok := m.terminalOrders[orderID]
+	if !ok || stored.level != level || stored.accountID != accountID {
+		writeMockNotFound(w, "Terminal order", orderID)
+		return nil, false
+	}
+	return stored, true
+}
+
+// findPlacedTerminalOrder looks up a terminal order that can still be changed, answering with a 422 if it cannot.
+func (m *mockManagementServer) findPlacedTerminalOrder(w http.ResponseWriter, level string, accountID string, orderID string) (*mockTerminalOrder, bool) {
+	stored, ok := m.findTerminalOrder(w, level, accountID, orderID)
+	if ok && stored.order.GetStatus() != "Placed" {
+		writeMockError(w, http.StatusUnprocessableEntity, "000_422", "Unprocessable Entity", "Order "+orderID+" has status "+stored.order.GetStatus()+".")
+		return nil, false
+	}
+	return stored, ok
+}
+
+func (m *mockManagementServer) createTerminalOrder(w http.ResponseWriter, r *http.Request, level string, accountID string) {
+	var req management.TerminalOrderRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	order := management.TerminalOrder{
+		Id:        common.PtrString(m.nextID("TO")),
+		OrderDate: common.PtrString("2024-01-01T12:00:00+01:00"),
+		Status:    common.PtrString("Placed"),
+	}
+	mapMockTerminalOrderRequest(&order, req)
+	m.terminalOrders[order.GetId()] = &mockTerminalOrder{level: level, accountID: accountID, order: order}
+
+	writeMockJSON(w, http.StatusOK, order)
+}
+
+// mapMockTerminalOrderRequest applies the fields of a create or update request to a terminal order.
+func mapMockTerminalOrderRequest(order *management.TerminalOrder, req management.TerminalOrderRequest) {
+	if req.BillingEntityId != nil {
+		order.BillingEntity = &management.BillingEntity{Id: req.BillingEntityId}
+	}
+	if req.ShippingLocationId != nil {
+		order.ShippingLocation = &management.ShippingLocation{Id: req.ShippingLocationId}
+	}
+	if req.CustomerOrderReference != nil {
+		order.CustomerOrderReference = req.CustomerOrderReference
+	}
+	if req.Items != nil {
+		order.Items = []management.OrderItem{}
+		for _, item := range req.Items {
+			if item.Name == nil {
+				item.Name = common.PtrString(mockTerminalProducts[item.GetId()])
+			}
+			order.Items = append(order.Items, item)
+		}
+	}
+}
+
+// setTerminalOrderStatus changes the status of a terminal order, to simulate Adyen processing the order.
+func (m *mockManagementServer) setTerminalOrderStatus(orderID string, status string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if stored, ok := m.terminalOrders[orderID]; ok {
+		stored.order.Status = &status
+	}
+}
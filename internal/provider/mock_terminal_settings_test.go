@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+func (m *mockManagementServer) registerTerminalSettingsRoutes() {
+	for level, pattern := range map[string]string{
+		"company":  "/companies/{id}/terminalSettings",
+		"merchant": "/merchants/{id}/terminalSettings",
+		"store":    "/stores/{id}/terminalSettings",
+		"terminal": "/terminals/{id}/terminalSettings",
+	} {
+		level := level
+		m.handle(http.MethodGet, pattern, func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if m.findTerminalSettingsLevel(w, level, params["id"]) {
+				writeMockJSON(w, http.StatusOK, m.inheritedTerminalSettingsOf(level, params["id"]))
+			}
+		})
+		m.handle(http.MethodPatch, pattern, func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if !m.findTerminalSettingsLevel(w, level, params["id"]) {
+				return
+			}
+			var req map[string]any
+			if !decodeMockRequest(w, r, &req) {
+				return
+			}
+
+			// Adyen only changes the settings in the request, so they are merged into the settings of the level itself.
+			var stored map[string]any
+			current, _ := json.Marshal(m.terminalSettingsOf(level, params["id"]))
+			_ = json.Unmarshal(current, &stored)
+			merged, _ := json.Marshal(mergeMockJSON(stored, req))
+
+			var settings management.TerminalSettings
+			_ = json.Unmarshal(merged, &settings)
+			m.terminalSettings[level+"/"+params["id"]] = &settings
+			writeMockJSON(w, http.StatusOK, m.inheritedTerminalSettingsOf(level, params["id"]))
+		})
+	}
+
+	for level, pattern := range map[string]string{
+		"company":  "/companies/{id}/terminalLogos",
+		"merchant": "/merchants/{id}/terminalLogos",
+		"store":    "/stores/{id}/terminalLogos",
+		"terminal": "/terminals/{id}/terminalLogos",
+	} {
+		level := level
+		m.handle(http.MethodGet, pattern, func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if m.findTerminalSettingsLevel(w, level, params["id"]) {
+				writeMockJSON(w, http.StatusOK, management.Logo{Data: m.terminalLogos[terminalLogoKey(level, params["id"], r)]})
+			}
+		})
+		m.handle(http.MethodPatch, pattern, func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if !m.findTerminalSettingsLevel(w, level, params["id"]) {
+				return
+			}
+			var req management.Logo
+			if !decodeMockRequest(w, r, &req) {
+				return
+			}
+
+			// An empty logo restores the logo inherited from the level above, which the mock server does not keep.
+			key := terminalLogoKey(level, params["id"], r)
+			if req.GetData() == "" {
+				delete(m.terminalLogos, key)
+			} else {
+				m.terminalLogos[key] = req.Data
+			}
+			writeMockJSON(w, http.StatusOK, management.Logo{Data: m.terminalLogos[key]})
+		})
+	}
+}
+
+// terminalLogoKey returns the key of a terminal logo stored by the mock server. Terminals have a single model,
+// so their logo is not stored per model.
+func terminalLogoKey(level string, id string, r *http.Request) string {
+	if level == "terminal" {
+		return level + "/" + id
+	}
+	return level + "/" + id + "/" + r.URL.Query().Get("model")
+}
+
+// findTerminalSettingsLevel checks that the company account, merchant account or store of terminal settings exists,
+// answering with a 422 if it does not. Any terminal is accepted, also the ones the mock server does not keep.
+func (m *mockManagementServer) findTerminalSettingsLevel(w http.ResponseWriter, level string, id string) bool {
+	var ok bool
+	switch level {
+	case "company":
+		_, ok = m.findCompanyAccount(w, id)
+	case "merchant":
+		_, ok = m.findMerchantAccount(w, id)
+	case "store":
+		_, ok = m.findStore(w, id)
+	default:
+		ok = true
+	}
+	return ok
+}
+
+// inheritedTerminalSettingsOf returns the terminal settings of a level as Adyen returns them: the settings of the level itself,
+// merged over the settings it inherits from the levels above.
+func (m *mockManagementServer) inheritedTerminalSettingsOf(level string, id string) management.TerminalSettings {
+	inherited := map[string]any{}
+	for _, parent := range m.terminalSettingsLevelsOf(level, id) {
+		var settings map[string]any
+		current, _ := json.Marshal(m.terminalSettingsOf(parent[0], parent[1]))
+		_ = json.Unmarshal(current, &settings)
+		inherited = mergeMockJSON(inherited, settings)
+	}
+
+	var settings management.TerminalSettings
+	merged, _ := json.Marshal(inherited)
+	_ = json.Unmarshal(merged, &settings)
+	return settings
+}
+
+// terminalSettingsLevelsOf returns the level and identifier of a level and of the levels above it, starting at the company account.
+func (m *mockManagementServer) terminalSettingsLevelsOf(level string, id string) [][2]string {
+	switch level {
+	case "merchant":
+		if merchant, ok := m.merchants[id]; ok && merchant.CompanyId != nil {
+			return append(m.terminalSettingsLevelsOf("company", merchant.GetCompanyId()), [2]string{level, id})
+		}
+	case "store":
+		if store, ok := m.stores[id]; ok && store.MerchantId != nil {
+			return append(m.terminalSettingsLevelsOf("merchant", store.GetMerchantId()), [2]string{level, id})
+		}
+	case "terminal":
+		if terminal, ok := m.terminals[id]; ok {
+			switch assignment := terminal.Assignment; {
+			case assignment.StoreId != nil:
+				return append(m.terminalSettingsLevelsOf("store", assignment.GetStoreId()), [2]string{level, id})
+			case assignment.MerchantId != nil:
+				return append(m.terminalSettingsLevelsOf("merchant", assignment.GetMerchantId()), [2]string{level, id})
+			default:
+				return append(m.terminalSettingsLevelsOf("company", assignment.CompanyId), [2]string{level, id})
+			}
+		}
+	}
+	return [][2]string{{level, id}}
+}
+
+// terminalSettingsOf returns the terminal settings stored for a level itself, which are empty until they are first updated.
+func (m *mockManagementServer) terminalSettingsOf(level string, id string) management.TerminalSettings {
+	if settings, ok := m.terminalSettings[level+"/"+id]; ok {
+		return *settings
+	}
+	return management.TerminalSettings{}
+}
+
+// mergeMockJSON merges a decoded JSON patch into a decoded JSON document: objects are merged key by key,
+// all other values (including lists) are replaced.
+func mergeMockJSON(document map[string]any, patch map[string]any) map[string]any {
+	if document == nil {
+		document = map[string]any{}
+	}
+	for key, value := range patch {
+		patchObject, isObject := value.(map[string]any)
+		documentObject, hasObject := document[key].(map[string]any)
+		if isObject && hasObject {
+			document[key] = mergeMockJSON(documentObject, patchObject)
+			continue
+		}
+		document[key] = value
+	}
+	return document
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+// mockTerminalAction is a terminal action stored by the mock server, together with the company account it belongs to.
+type mockTerminalAction struct {
+	companyID string
+	action    management.ExternalTerminalAction
+}
+
+// seedMockTerminals adds the payment terminals the acceptance tests refer to, which are in the inventory of the company account.
+func (m *mockManagementServer) seedMockTerminals() {
+	for _, terminal := range []management.Terminal{
+		{
+			Id:           common.PtrString("V400m-080020970"),
+			Model:        common.PtrString("V400m"),
+			SerialNumber: common.PtrString("080-020-970"),
+			Assignment:   &management.TerminalAssignment{CompanyId: "WeaveAccount", MerchantId: common.PtrString("WeaveAccountPOS"), Status: "boarded"},
+		},
+		{
+			Id:           common.PtrString("V400m-080020971"),
+			Model:        common.PtrString("V400m"),
+			SerialNumber: common.PtrString("080-020-971"),
+			Assignment:   &management.TerminalAssignment{CompanyId: "WeaveAccount", Status: "inventory"},
+		},
+		{
+			Id:           common.PtrString("S1F2-000158212345678"),
+			Model:        common.PtrString("S1F2"),
+			SerialNumber: common.PtrString("0001582-12345678"),
+			Assignment:   &management.TerminalAssignment{CompanyId: "WeaveAccount", Status: "inventory"},
+		},
+		{
+			Id:           common.PtrString("P400Plus-275039202"),
+			Model:        common.PtrString("P400Plus"),
+			SerialNumber: common.PtrString("275-039-202"),
+			Assignment:   &management.TerminalAssignment{CompanyId: "WeaveAccount", Status: "inventory"},
+		},
+		{
+			Id:           common.PtrString("AMS1-000168223606144"),
+			Model:        common.PtrString("AMS1"),
+			SerialNumber: common.PtrString("000168223606144"),
+			Assignment:   &management.TerminalAssignment{CompanyId: "WeaveAccount", Status: "inventory"},
+		},
+	} {
+		terminal := terminal
+		m.terminals[terminal.GetId()] = &terminal
+	}
+}
+
+func (m *mockManagementServer) registerTerminalRoutes() {
+	m.seedMockTerminals()
+
+	m.handle(http.MethodGet, "/terminals", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		m.listTerminals(w, r)
+	})
+	m.handle(http.MethodPost, "/terminals/{terminalId}/reassign", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		terminal, ok := m.terminals[params["terminalId"]]
+		if !ok {
+			writeMockNotFound(w, "Terminal", params["terminalId"])
+			return
+		}
+		m.reassignTerminal(w, r, terminal)
+	})
+	m.handle(http.MethodPost, "/terminals/scheduleActions", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		m.scheduleTerminalActions(w, r)
+	})
+	m.handle(http.MethodGet, "/companies/{companyId}/terminalActions/{actionId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		stored, ok := m.terminalActions[params["actionId"]]
+		if !ok || stored.companyID != params["companyId"] {
+			writeMockNotFound(w, "Terminal action", params["actionId"])
+			return
+		}
+		writeMockJSON(w, http.StatusOK, stored.action)
+	})
+}
+
+func (m *mockManagementServer) scheduleTerminalActions(w http.ResponseWriter, r *http.Request) {
+	var req management.ScheduleTerminalActionsRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	var scheduledAt *time.Time
+	if req.ScheduledAt != nil {
+		parsed, err := time.Parse(time.RFC3339, req.GetScheduledAt())
+		if err != nil {
+			writeMockError(w, http.StatusUnprocessableEntity, "000_422", "Unprocessable Entity", "Invalid scheduledAt: "+err.Error())
+			return
+		}
+		scheduledAt = &parsed
+	}
+	details, _ := json.Marshal(req.ActionDetails)
+	var actionType struct {
+		Type string `json:"type"`
+	}
+	_ = json.Unmarshal(details, &actionType)
+
+	// Like Adyen, the action is scheduled on the terminals that are valid, and the others are reported as errors.
+	res := management.ScheduleTerminalActionsResponse{
+		ActionDetails:       req.ActionDetails,
+		ScheduledAt:         req.ScheduledAt,
+		StoreId:             req.StoreId,
+		TerminalsWithErrors: &map[string][]string{},
+	}
+	for _, terminalID := range req.TerminalIds {
+		terminal, ok := m.terminals[terminalID]
+		if !ok {
+			(*res.TerminalsWithErrors)["Terminal not found"] = append((*res.TerminalsWithErrors)["Terminal not found"], terminalID)
+			continue
+		}
+		if req.StoreId != nil && terminal.Assignment.GetStoreId() != req.GetStoreId() {
+			(*res.TerminalsWithErrors)["Terminal not assigned to store"] = append((*res.TerminalsWithErrors)["Terminal not assigned to store"], terminalID)
+			continue
+		}
+
+		action := management.ExternalTerminalAction{
+			Id:          common.PtrString(m.nextID("TRAC")),
+			ActionType:  &actionType.Type,
+			Config:      common.PtrString(string(details)),
+			ScheduledAt: scheduledAt,
+			Status:      common.PtrString("pending"),
+			TerminalId:  common.PtrString(terminalID),
+		}
+		m.terminalActions[action.GetId()] = &mockTerminalAction{companyID: terminal.Assignment.CompanyId, action: action}
+		res.Items = append(res.Items, management.TerminalActionScheduleDetail{Id: action.Id, TerminalId: action.TerminalId})
+	}
+	res.TotalScheduled = common.PtrInt32(int32(len(res.Items)))
+	res.TotalErrors = common.PtrInt32(int32(len(req.TerminalIds) - len(res.Items)))
+
+	writeMockJSON(w, http.StatusOK, res)
+}
+
+// completeTerminalActions marks the pending actions on a terminal as carried out, to simulate the terminal's maintenance call.
+func (m *mockManagementServer) completeTerminalActions(terminalID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	for _, stored := range m.terminalActions {
+		if stored.action.GetTerminalId() == terminalID && stored.action.GetStatus() == "pending" {
+			stored.action.Status = common.PtrString("successful")
+			stored.action.Result = common.PtrString("Action completed")
+			stored.action.ConfirmedAt = &now
+		}
+	}
+}
+
+// addTerminal adds a terminal to the inventory of a company account, to simulate a terminal that is ordered after an action was scheduled.
+func (m *mockManagementServer) addTerminal(terminalID string, companyID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	model, serialNumber, _ := strings.Cut(terminalID, "-")
+	m.terminals[terminalID] = &management.Terminal{
+		Id:           common.PtrString(terminalID),
+		Model:        common.PtrString(model),
+		SerialNumber: common.PtrString(serialNumber),
+		Assignment:   &management.TerminalAssignment{CompanyId: companyID, Status: "inventory"},
+	}
+}
+
+// expireTerminalActions removes the actions on a terminal, like Adyen does for actions that are no longer available.
+func (m *mockManagementServer) expireTerminalActions(terminalID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, stored := range m.terminalActions {
+		if stored.action.GetTerminalId() == terminalID {
+			delete(m.terminalActions, id)
+		}
+	}
+}
+
+func (m *mockManagementServer) listTerminals(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := func(name string) []string {
+		if query.Get(name) == "" {
+			return nil
+		}
+		return strings.Split(query.Get(name), ",")
+	}
+	countries, merchantIDs, storeIDs, brandModels := filter("countries"), filter("merchantIds"), filter("storeIds"), filter("brandModels")
+
+	terminals := []management.Terminal{}
+	for _, terminal := range m.terminals {
+		// Like Adyen, the other filters are ignored when searching by terminal ID.
+		if searchQuery := query.Get("searchQuery"); searchQuery != "" {
+			if strings.Contains(terminal.GetId(), searchQuery) {
+				terminals = append(terminals, *terminal)
+			}
+			continue
+		}
+
+		country := ""
+		if store, ok := m.stores[terminal.Assignment.GetStoreId()]; ok {
+			country = store.Address.Country
+		}
+		if (countries != nil && !slices.Contains(countries, country)) ||
+			(merchantIDs != nil && !slices.Contains(merchantIDs, terminal.Assignment.GetMerchantId())) ||
+			(storeIDs != nil && !slices.Contains(storeIDs, terminal.Assignment.GetStoreId())) ||
+			(brandModels != nil && !slices.ContainsFunc(brandModels, func(brandModel string) bool {
+				return strings.HasSuffix(brandModel, "."+terminal.GetModel())
+			})) {
+			continue
+		}
+		terminals = append(terminals, *terminal)
+	}
+	sort.Slice(terminals, func(i, j int) bool { return terminals[i].GetId() < terminals[j].GetId() })
+
+	start, end := mockPage(r, len(terminals))
+	pageSize := mockPageSize(r)
+	writeMockJSON(w, http.StatusOK, management.ListTerminalsResponse{
+		Data:       terminals[start:end],
+		ItemsTotal: int32(len(terminals)),
+		PagesTotal: int32((len(terminals) + pageSize - 1) / pageSize),
+	})
+}
+
+func (m *mockManagementServer) reassignTerminal(w http.ResponseWriter, r *http.Request, terminal *management.Terminal) {
+	var req management.TerminalReassignmentRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	var assignment management.TerminalAssignment
+	switch {
+	case req.StoreId != nil:
+		store, ok := m.findStore(w, req.GetStoreId())
+		if !ok {
+			return
+		}
+		merchant, ok := m.findMerchantAccount(w, store.GetMerchantId())
+		if !ok {
+			return
+		}
+		assignment = management.TerminalAssignment{CompanyId: merchant.GetCompanyId(), MerchantId: merchant.Id, StoreId: store.Id, Status: "boarded"}
+	case req.MerchantId != nil:
+		merchant, ok := m.findMerchantAccount(w, req.GetMerchantId())
+		if !ok {
+			return
+		}
+		assignment = management.TerminalAssignment{CompanyId: merchant.GetCompanyId(), MerchantId: merchant.Id, Status: "boarded"}
+		if req.GetInventory() {
+			assignment.Status = "inventory"
+		}
+	case req.CompanyId != nil:
+		if _, ok := m.findCompanyAccount(w, req.GetCompanyId()); !ok {
+			return
+		}
+		assignment = management.TerminalAssignment{CompanyId: req.GetCompanyId(), Status: "inventory"}
+	default:
+		writeMockError(w, http.StatusUnprocessableEntity, "000_422", "Unprocessable Entity", "Specify a companyId, merchantId or storeId to reassign the terminal to.")
+		return
+	}
+
+	// Like Adyen, a boarded terminal keeps its assignment until its next maintenance call, so the reassignment is only recorded.
+	if terminal.Assignment.Status == "boarded" || terminal.Assignment.Status == "reassignmentInProgress" {
+		terminal.Assignment.Status = "reassignmentInProgress"
+		terminal.Assignment.ReassignmentTarget = &management.TerminalReassignmentTarget{
+			CompanyId:  common.PtrString(assignment.CompanyId),
+			MerchantId: assignment.MerchantId,
+			StoreId:    assignment.StoreId,
+			Inventory:  assignment.Status == "inventory",
+		}
+	} else {
+		terminal.Assignment = &assignment
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// completeTerminalReassignment carries out the pending reassignment of a terminal, to simulate the terminal's maintenance call.
+func (m *mockManagementServer) completeTerminalReassignment(terminalID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	terminal := m.terminals[terminalID]
+	target := terminal.Assignment.ReassignmentTarget
+	if target == nil {
+		return
+	}
+
+	terminal.Assignment = &management.TerminalAssignment{
+		CompanyId:  target.GetCompanyId(),
+		MerchantId: target.MerchantId,
+		StoreId:    target.StoreId,
+		Status:     "boarded",
+	}
+	if target.Inventory {
+		terminal.Assignment.Status = "inventory"
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+// mockUser is a Customer Area user stored by the mock server, together with the account it belongs to.
+// The company user is a superset of the merchant user, so it is used for both.
+type mockUser struct {
+	level     string // "merchants" or "companies"
+	accountID string
+	user      management.CompanyUser
+}
+
+func (m *mockManagementServer) registerUserRoutes() {
+	for _, level := range []string{"merchants", "companies"} {
+		level := level
+		m.handle(http.MethodPost, "/"+level+"/{accountId}/users", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			m.createUser(w, r, level, params["accountId"])
+		})
+		m.handle(http.MethodGet, "/"+level+"/{accountId}/users", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			m.listUsers(w, level, params["accountId"])
+		})
+		m.handle(http.MethodGet, "/"+level+"/{accountId}/users/{userId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findUser(w, level, params["accountId"], params["userId"]); ok {
+				writeMockJSON(w, http.StatusOK, stored.user)
+			}
+		})
+		m.handle(http.MethodPatch, "/"+level+"/{accountId}/users/{userId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findUser(w, level, params["accountId"], params["userId"]); ok {
+				m.updateUser(w, r, stored)
+			}
+		})
+	}
+}
+
+// findUser looks up a user of the given account, answering with a 422 if it does not exist.
+func (m *mockManagementServer) findUser(w http.ResponseWriter, level string, accountID string, userID string) (*mockUser, bool) {
+	stored, ok := m.users[userID]
+	if !ok || stored.level != level || stored.accountID != accountID {
+		writeMockNotFound(w, "User", userID)
+		return nil, false
+	}
+	return stored, true
+}
+
+func (m *mockManagementServer) createUser(w http.ResponseWriter, r *http.Request, level string, accountID string) {
+	// The company request is a superset of the merchant request, so it can be used to decode both.
+	var req management.CreateCompanyUserRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	name := req.Name
+	user := management.CompanyUser{
+		Id:            m.nextID("S2-"),
+		Username:      req.Username,
+		Email:         req.Email,
+		Name:          &name,
+		TimeZoneCode:  "Europe/Amsterdam",
+		Roles:         req.Roles,
+		AccountGroups: req.AccountGroups,
+		Apps:          []string{},
+		Active:        common.PtrBool(true),
+	}
+	if req.TimeZoneCode != nil {
+		user.TimeZoneCode = *req.TimeZoneCode
+	}
+	if user.Roles == nil {
+		user.Roles = []string{}
+	}
+	if level == "companies" {
+		user.AssociatedMerchantAccounts = req.AssociatedMerchantAccounts
+	}
+
+	m.users[user.Id] = &mockUser{level: level, accountID: accountID, user: user}
+	writeMockJSON(w, http.StatusOK, user)
+}
+
+func (m *mockManagementServer) updateUser(w http.ResponseWriter, r *http.Request, stored *mockUser) {
+	// The company request is a superset of the merchant request, so it can be used to decode both.
+	var req management.UpdateCompanyUserRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	user := &stored.user
+	if req.Email != nil {
+		user.Email = *req.Email
+	}
+	if req.Name != nil {
+		if req.Name.FirstName != nil {
+			user.Name.FirstName = *req.Name.FirstName
+		}
+		if req.Name.LastName != nil {
+			user.Name.LastName = *req.Name.LastName
+		}
+	}
+	if req.TimeZoneCode != nil {
+		user.TimeZoneCode = *req.TimeZoneCode
+	}
+	if req.Roles != nil {
+		user.Roles = req.Roles
+	}
+	if req.AccountGroups != nil {
+		user.AccountGroups = req.AccountGroups
+	}
+	if req.Active != nil {
+		user.Active = req.Active
+	}
+	if stored.level == "companies" && req.AssociatedMerchantAccounts != nil {
+		user.AssociatedMerchantAccounts = req.AssociatedMerchantAccounts
+	}
+
+	writeMockJSON(w, http.StatusOK, user)
+}
+
+func (m *mockManagementServer) listUsers(w http.ResponseWriter, level string, accountID string) {
+	data := make([]management.CompanyUser, 0)
+	for _, stored := range m.users {
+		if stored.level == level && stored.accountID == accountID {
+			data = append(data, stored.user)
+		}
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i].Id < data[j].Id })
+
+	writeMockJSON(w, http.StatusOK, management.ListCompanyUsersResponse{
+		Data:       data,
+		ItemsTotal: int32(len(data)),
+		PagesTotal: 1,
+	})
+}
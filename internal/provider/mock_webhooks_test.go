@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+)
+
+// mockWebhook is a webhook stored by the mock server, together with the account it belongs to.
+type mockWebhook struct {
+	level     string // "merchants" or "companies"
+	accountID string
+	webhook   management.Webhook
+}
+
+func (m *mockManagementServer) registerWebhookRoutes() {
+	for _, level := range []string{"merchants", "companies"} {
+		level := level
+		m.handle(http.MethodPost, "/"+level+"/{accountId}/webhooks", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			m.createWebhook(w, r, level, params["accountId"])
+		})
+		m.handle(http.MethodGet, "/"+level+"/{accountId}/webhooks", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			m.listWebhooks(w, level, params["accountId"])
+		})
+		m.handle(http.MethodGet, "/"+level+"/{accountId}/webhooks/{webhookId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findWebhook(w, level, params["accountId"], params["webhookId"]); ok {
+				writeMockJSON(w, http.StatusOK, stored.webhook)
+			}
+		})
+		m.handle(http.MethodPatch, "/"+level+"/{accountId}/webhooks/{webhookId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findWebhook(w, level, params["accountId"], params["webhookId"]); ok {
+				m.updateWebhook(w, r, stored)
+			}
+		})
+		m.handle(http.MethodDelete, "/"+level+"/{accountId}/webhooks/{webhookId}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if _, ok := m.findWebhook(w, level, params["accountId"], params["webhookId"]); ok {
+				delete(m.webhooks, params["webhookId"])
+				w.WriteHeader(http.StatusNoContent)
+			}
+		})
+		m.handle(http.MethodPost, "/"+level+"/{accountId}/webhooks/{webhookId}/generateHmac", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if _, ok := m.findWebhook(w, level, params["accountId"], params["webhookId"]); ok {
+				writeMockJSON(w, http.StatusOK, management.GenerateHmacKeyResponse{HmacKey: m.nextID("HMAC")})
+			}
+		})
+		m.handle(http.MethodPost, "/"+level+"/{accountId}/webhooks/{webhookId}/test", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			if stored, ok := m.findWebhook(w, level, params["accountId"], params["webhookId"]); ok {
+				m.testWebhook(w, r, stored)
+			}
+		})
+	}
+}
+
+// findWebhook looks up a webhook of the given account, answering with a 422 if it does not exist.
+func (m *mockManagementServer) findWebhook(w http.ResponseWriter, level string, accountID string, webhookID string) (*mockWebhook, bool) {
+	stored, ok := m.webhooks[webhookID]
+	if !ok || stored.level != level || stored.accountID != accountID {
+		writeMockNotFound(w, "Webhook", webhookID)
+		return nil, false
+	}
+	return stored, true
+}
+
+func (m *mockManagementServer) createWebhook(w http.ResponseWriter, r *http.Request, level string, accountID string) {
+	// The company request is a superset of the merchant request, so it can be used to decode both.
+	var req management.CreateCompanyWebhookRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	id := m.nextID("WBHK")
+	webhook := management.Webhook{
+		Id:                              &id,
+		Type:                            req.Type,
+		Url:                             req.Url,
+		Username:                        req.Username,
+		Description:                     req.Description,
+		Active:                          req.Active,
+		CommunicationFormat:             req.CommunicationFormat,
+		EncryptionProtocol:              req.EncryptionProtocol,
+		AcceptsExpiredCertificate:       req.AcceptsExpiredCertificate,
+		AcceptsSelfSignedCertificate:    req.AcceptsSelfSignedCertificate,
+		AcceptsUntrustedRootCertificate: req.AcceptsUntrustedRootCertificate,
+		PopulateSoapActionHeader:        req.PopulateSoapActionHeader,
+		NetworkType:                     req.NetworkType,
+		HasError:                        common.PtrBool(false),
+		HasPassword:                     common.PtrBool(req.Password != nil && *req.Password != ""),
+	}
+	if webhook.EncryptionProtocol == nil {
+		webhook.EncryptionProtocol = common.PtrString("TLSv1.2")
+	}
+	if webhook.PopulateSoapActionHeader == nil {
+		webhook.PopulateSoapActionHeader = common.PtrBool(false)
+	}
+	if level == "companies" {
+		webhook.FilterMerchantAccountType = &req.FilterMerchantAccountType
+		webhook.FilterMerchantAccounts = req.FilterMerchantAccounts
+	}
+	webhook.AdditionalSettings = &management.AdditionalSettingsResponse{
+		IncludeEventCodes: []string{},
+		ExcludeEventCodes: []string{},
+		Properties:        &map[string]bool{},
+	}
+	if req.AdditionalSettings != nil {
+		m.applyWebhookAdditionalSettings(&webhook, req.AdditionalSettings)
+	}
+	webhook.Links = m.webhookLinks(level, accountID, id)
+
+	m.webhooks[id] = &mockWebhook{level: level, accountID: accountID, webhook: webhook}
+	writeMockJSON(w, http.StatusOK, webhook)
+}
+
+func (m *mockManagementServer) updateWebhook(w http.ResponseWriter, r *http.Request, stored *mockWebhook) {
+	var req management.UpdateCompanyWebhookRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	webhook := &stored.webhook
+	if req.Url != nil {
+		webhook.Url = *req.Url
+	}
+	if req.Username != nil {
+		webhook.Username = req.Username
+	}
+	if req.Password != nil {
+		webhook.HasPassword = common.PtrBool(*req.Password != "")
+	}
+	if req.Description != nil {
+		webhook.Description = req.Description
+	}
+	if req.Active != nil {
+		webhook.Active = *req.Active
+	}
+	if req.CommunicationFormat != nil {
+		webhook.CommunicationFormat = *req.CommunicationFormat
+	}
+	if req.EncryptionProtocol != nil {
+		webhook.EncryptionProtocol = req.EncryptionProtocol
+	}
+	if req.AcceptsExpiredCertificate != nil {
+		webhook.AcceptsExpiredCertificate = req.AcceptsExpiredCertificate
+	}
+	if req.AcceptsSelfSignedCertificate != nil {
+		webhook.AcceptsSelfSignedCertificate = req.AcceptsSelfSignedCertificate
+	}
+	if req.AcceptsUntrustedRootCertificate != nil {
+		webhook.AcceptsUntrustedRootCertificate = req.AcceptsUntrustedRootCertificate
+	}
+	if req.PopulateSoapActionHeader != nil {
+		webhook.PopulateSoapActionHeader = req.PopulateSoapActionHeader
+	}
+	if req.NetworkType != nil {
+		webhook.NetworkType = req.NetworkType
+	}
+	if stored.level == "companies" {
+		if req.FilterMerchantAccountType != nil {
+			webhook.FilterMerchantAccountType = req.FilterMerchantAccountType
+		}
+		if req.FilterMerchantAccounts != nil {
+			webhook.FilterMerchantAccounts = req.FilterMerchantAccounts
+		}
+	}
+	if req.AdditionalSettings != nil {
+		m.applyWebhookAdditionalSettings(webhook, req.AdditionalSettings)
+	}
+
+	writeMockJSON(w, http.StatusOK, webhook)
+}
+
+func (m *mockManagementServer) applyWebhookAdditionalSettings(webhook *management.Webhook, settings *management.AdditionalSettings) {
+	if settings.IncludeEventCodes != nil {
+		webhook.AdditionalSettings.IncludeEventCodes = settings.IncludeEventCodes
+	}
+	if settings.Properties != nil {
+		webhook.AdditionalSettings.Properties = settings.Properties
+	}
+}
+
+// testWebhook answers with a successful test result for every requested event type and merchant account.
+func (m *mockManagementServer) testWebhook(w http.ResponseWriter, r *http.Request, stored *mockWebhook) {
+	// The company request is a superset of the merchant request, so it can be used to decode both.
+	var req management.TestCompanyWebhookRequest
+	if !decodeMockRequest(w, r, &req) {
+		return
+	}
+
+	merchantIDs := req.MerchantIds
+	if stored.level == "merchants" {
+		merchantIDs = []string{stored.accountID}
+	} else if len(merchantIDs) == 0 {
+		merchantIDs = stored.webhook.FilterMerchantAccounts
+	}
+
+	data := make([]management.TestOutput, 0)
+	for _, merchantID := range merchantIDs {
+		for _, eventType := range req.Types {
+			data = append(data, management.TestOutput{
+				MerchantId:   common.PtrString(merchantID),
+				Output:       common.PtrString("[accepted]"),
+				RequestSent:  common.PtrString(fmt.Sprintf(`{"live":"false","notificationItems":[{"NotificationRequestItem":{"eventCode":"%s","merchantAccountCode":"%s"}}]}`, eventType, merchantID)),
+				ResponseCode: common.PtrString("200"),
+				ResponseTime: common.PtrString("42 ms"),
+				Status:       "success",
+			})
+		}
+	}
+
+	writeMockJSON(w, http.StatusOK, management.TestWebhookResponse{Data: data})
+}
+
+func (m *mockManagementServer) listWebhooks(w http.ResponseWriter, level string, accountID string) {
+	data := make([]management.Webhook, 0)
+	for _, stored := range m.webhooks {
+		if stored.level == level && stored.accountID == accountID {
+			data = append(data, stored.webhook)
+		}
+	}
+	sort.Slice(data, func(i, j int) bool { return *data[i].Id < *data[j].Id })
+
+	writeMockJSON(w, http.StatusOK, management.ListWebhooksResponse{
+		Data:       data,
+		ItemsTotal: int32(len(data)),
+		PagesTotal: 1,
+	})
+}
+
+func (m *mockManagementServer) webhookLinks(level string, accountID string, webhookID string) *management.WebhookLinks {
+	links := &management.WebhookLinks{
+		Self:         management.LinksElement{Href: m.href("/%s/%s/webhooks/%s", level, accountID, webhookID)},
+		GenerateHmac: management.LinksElement{Href: m.href("/%s/%s/webhooks/%s/generateHmac", level, accountID, webhookID)},
+		TestWebhook:  management.LinksElement{Href: m.href("/%s/%s/webhooks/%s/test", level, accountID, webhookID)},
+	}
+	if level == "companies" {
+		links.Company = &management.LinksElement{Href: m.href("/companies/%s", accountID)}
+	} else {
+		links.Merchant = &management.LinksElement{Href: m.href("/merchants/%s", accountID)}
+	}
+	return links
+}
@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &paymentMethodApplePayDomainsResource{}
+	_ resource.ResourceWithConfigure   = &paymentMethodApplePayDomainsResource{}
+	_ resource.ResourceWithImportState = &paymentMethodApplePayDomainsResource{}
+	_ resource.ResourceWithModifyPlan  = &paymentMethodApplePayDomainsResource{}
+)
+
+// paymentMethodApplePayDomainsResource is the resource implementation.
+type paymentMethodApplePayDomainsResource struct {
+	client *adyen.APIClient
+}
+
+// NewPaymentMethodApplePayDomainsResource is a helper function to simplify the provider implementation.
+func NewPaymentMethodApplePayDomainsResource() resource.Resource {
+	return &paymentMethodApplePayDomainsResource{}
+}
+
+// paymentMethodApplePayDomainsResourceModel maps the "payment_method_apple_pay_domains" schema data for a resource.
+type paymentMethodApplePayDomainsResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	MerchantID        types.String `tfsdk:"merchant_id"`
+	PaymentMethodID   types.String `tfsdk:"payment_method_id"`
+	Domains           types.Set    `tfsdk:"domains"`
+	RegisteredDomains types.Set    `tfsdk:"registered_domains"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *paymentMethodApplePayDomainsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *paymentMethodApplePayDomainsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payment_method_apple_pay_domains"
+}
+
+// Schema defines the schema for the resource.
+func (r *paymentMethodApplePayDomainsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers domains for Apple Pay on the web with an Apple Pay payment method. Only the configured domains are managed, so domains that are already registered, " +
+			"e.g. through the apple_pay block of adyen_payment_method, can be left out. All domains registered with the payment method are listed in registered_domains, " +
+			"so domains registered outside of Terraform show up as changes made outside of Terraform.\n\n" +
+			"The Management API can only add domains, so removing a domain from the configuration fails during plan, and destroying this resource only removes it from the Terraform state.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Payment methods read and write",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the payment method, same as payment_method_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"merchant_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the merchant account. Defaults to the merchant account of the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"payment_method_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the Apple Pay payment method.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domains": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The merchant domains to register for Apple Pay. Maximum: 99 domains per request.",
+			},
+			"registered_domains": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "All merchant domains registered for Apple Pay with the payment method, including the ones not managed by this resource.",
+			},
+		},
+	}
+}
+
+// getDomains returns all domains registered with the Apple Pay payment method.
+func (r *paymentMethodApplePayDomainsResource) getDomains(ctx context.Context, model paymentMethodApplePayDomainsResourceModel) ([]string, *http.Response, error) {
+	getApplePayDomainsInput := r.client.Management().PaymentMethodsMerchantLevelApi.GetApplePayDomainsInput(model.MerchantID.ValueString(), model.PaymentMethodID.ValueString())
+	applePayInfo, httpRes, err := r.client.Management().PaymentMethodsMerchantLevelApi.GetApplePayDomains(ctx, getApplePayDomainsInput)
+	return applePayInfo.Domains, httpRes, err
+}
+
+// addDomains registers the given domains that are not registered yet with the Apple Pay payment method, and reads the registered domains back.
+func (r *paymentMethodApplePayDomainsResource) addDomains(ctx context.Context, model *paymentMethodApplePayDomainsResourceModel, domains []string) error {
+	registered, _, err := r.getDomains(ctx, *model)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, domain := range domains {
+		if !slices.Contains(registered, domain) {
+			missing = append(missing, domain)
+		}
+	}
+	if len(missing) > 0 {
+		addApplePayDomainInput := r.client.Management().PaymentMethodsMerchantLevelApi.AddApplePayDomainInput(model.MerchantID.ValueString(), model.PaymentMethodID.ValueString()).ApplePayInfo(management.ApplePayInfo{Domains: missing})
+		if _, err = r.client.Management().PaymentMethodsMerchantLevelApi.AddApplePayDomain(ctx, addApplePayDomainInput); err != nil {
+			return err
+		}
+
+		registered, _, err = r.getDomains(ctx, *model)
+		if err != nil {
+			return err
+		}
+	}
+	model.RegisteredDomains = mapStringsToSet(registered)
+	return nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *paymentMethodApplePayDomainsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Adding adyen Apple Pay domains")
+
+	// Retrieve values from the plan
+	var plan paymentMethodApplePayDomainsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains, diags := mapSetToStrings(ctx, plan.Domains)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.MerchantID.IsUnknown() {
+		plan.MerchantID = types.StringValue(r.client.GetConfig().MerchantAccount)
+	}
+	plan.ID = plan.PaymentMethodID
+
+	err := r.addDomains(ctx, &plan, domains)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Apple Pay domains",
+			"Could not add Apple Pay domains to payment method "+plan.PaymentMethodID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state with the registered domains
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *paymentMethodApplePayDomainsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state paymentMethodApplePayDomainsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.PaymentMethodID = state.ID
+	registered, httpRes, err := r.getDomains(ctx, state)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the payment method does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Apple Pay Domains",
+			"Could not read Apple Pay domains of payment method "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// An imported resource manages all registered domains, otherwise only the managed domains that are still registered are kept.
+	managed := registered
+	if !state.Domains.IsNull() {
+		domains, diags := mapSetToStrings(ctx, state.Domains)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		managed = slices.DeleteFunc(domains, func(domain string) bool { return !slices.Contains(registered, domain) })
+	}
+	state.Domains = mapStringsToSet(managed)
+	state.RegisteredDomains = mapStringsToSet(registered)
+
+	tflog.Debug(ctx, "Reading Apple Pay domains...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan rejects removing domains during plan, as the Management API can only add domains.
+func (r *paymentMethodApplePayDomainsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the domains are added for the first time or removed from state.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state paymentMethodApplePayDomainsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Domains.IsUnknown() {
+		return
+	}
+	// A different payment method or merchant account replaces the resource, so the domains do not need to stay.
+	if !plan.PaymentMethodID.Equal(state.PaymentMethodID) || !plan.MerchantID.Equal(state.MerchantID) {
+		return
+	}
+
+	planned, diags := mapSetToStrings(ctx, plan.Domains)
+	resp.Diagnostics.Append(diags...)
+	managed, diags := mapSetToStrings(ctx, state.Domains)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var removed []string
+	for _, domain := range managed {
+		if !slices.Contains(planned, domain) {
+			removed = append(removed, domain)
+		}
+	}
+	if len(removed) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domains"),
+			"Apple Pay Domains Cannot Be Removed",
+			"The Management API cannot remove Apple Pay domains, so the following domains must stay registered: "+strings.Join(removed, ", ")+".",
+		)
+	}
+}
+
+// Update adds the new domains and sets the updated Terraform state on success.
+func (r *paymentMethodApplePayDomainsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating adyen Apple Pay domains")
+
+	// Retrieve values from the plan
+	var plan paymentMethodApplePayDomainsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains, diags := mapSetToStrings(ctx, plan.Domains)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.addDomains(ctx, &plan, domains)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Apple Pay domains",
+			"Could not add Apple Pay domains to payment method "+plan.PaymentMethodID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the Apple Pay domains from the Terraform state. The Management API cannot remove them, they stay registered with the payment method.
+func (r *paymentMethodApplePayDomainsResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing Apple Pay domains from state")
+}
+
+// ImportState imports the Apple Pay domains of a payment method using its merchant account and identifier.
+func (r *paymentMethodApplePayDomainsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	merchantID, paymentMethodID, err := splitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import identifier of the form '<merchant_id>/<payment_method_id>': "+err.Error(),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the id attribute, Read fills in the remaining state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("merchant_id"), merchantID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), paymentMethodID)...)
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccPaymentMethodApplePayDomainsResource(t *testing.T) {
+	resourceName := "adyen_payment_method_apple_pay_domains.test"
+	var paymentMethodID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				// www.example.com is registered through the apple_pay block and left out, so only shop.example.com is managed here.
+				Config: testProviderClientFromTmpl(t) + testConfigPaymentMethodApplePayDomains(`["shop.example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "adyen_payment_method.applepay", "id"),
+					resource.TestCheckResourceAttr(resourceName, "merchant_id", "WeaveAccountECOM"),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "domains.*", "shop.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "registered_domains.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "registered_domains.*", "www.example.com"),
+					func(s *terraform.State) error {
+						paymentMethodID = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// An imported resource manages all registered domains.
+				ImportStateVerifyIgnore: []string{"domains"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+					return rs.Primary.Attributes["merchant_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigPaymentMethodApplePayDomains(`["shop.example.com", "pay.example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "domains.*", "pay.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "registered_domains.#", "3"),
+				),
+			},
+			{
+				// The Management API cannot remove domains, which must fail during plan.
+				Config:      testProviderClientFromTmpl(t) + testConfigPaymentMethodApplePayDomains(`["shop.example.com"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`following domains\s+must stay registered: pay.example.com`),
+			},
+			{
+				// Registering a domain outside of Terraform must show up in registered_domains without changing the managed domains.
+				PreConfig: func() {
+					suite := new(AcceptanceSuite)
+					suite.SetupSuite()
+					client := suite.client
+
+					addApplePayDomainInput := client.Management().PaymentMethodsMerchantLevelApi.AddApplePayDomainInput(client.GetConfig().MerchantAccount, paymentMethodID).ApplePayInfo(management.ApplePayInfo{Domains: []string{"other.example.com"}})
+					if _, err := client.Management().PaymentMethodsMerchantLevelApi.AddApplePayDomain(context.Background(), addApplePayDomainInput); err != nil {
+						t.Fatalf("could not add Apple Pay domain to payment method %s: %s", paymentMethodID, err)
+					}
+				},
+				Config: testProviderClientFromTmpl(t) + testConfigPaymentMethodApplePayDomains(`["shop.example.com", "pay.example.com"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "registered_domains.#", "4"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "registered_domains.*", "other.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testConfigPaymentMethodApplePayDomains(domains string) string {
+	return fmt.Sprintf(`
+	resource "adyen_payment_method" "applepay" {
+		type = "applepay"
+		apple_pay = {
+			domains = ["www.example.com"]
+		}
+	}
+
+	resource "adyen_payment_method_apple_pay_domains" "test" {
+		payment_method_id = adyen_payment_method.applepay.id
+		domains           = %s
+	}
+`, domains)
+}
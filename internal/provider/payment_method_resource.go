@@ -0,0 +1,833 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &paymentMethodResource{}
+	_ resource.ResourceWithConfigure   = &paymentMethodResource{}
+	_ resource.ResourceWithImportState = &paymentMethodResource{}
+	_ resource.ResourceWithModifyPlan  = &paymentMethodResource{}
+)
+
+// Allowed values of the sales channel of a payment method.
+var paymentMethodShopperInteractions = []string{"eCommerce", "pos", "contAuth", "moto"}
+
+// paymentMethodApprovalTimeout is how long apply waits for Adyen to review a payment method that is pending approval,
+// unless the create or update timeout is configured.
+const paymentMethodApprovalTimeout = 30 * time.Minute
+
+// paymentMethodApprovalPollInterval is the time between two checks of the verification status of a pending payment method.
+const paymentMethodApprovalPollInterval = 10 * time.Second
+
+// paymentMethodResource is the resource implementation.
+type paymentMethodResource struct {
+	client *adyen.APIClient
+}
+
+// NewPaymentMethodResource is a helper function to simplify the provider implementation.
+func NewPaymentMethodResource() resource.Resource {
+	return &paymentMethodResource{}
+}
+
+// paymentMethodResourceModel maps the "payment_method" schema data for a resource.
+type paymentMethodResourceModel struct {
+	ID                 types.String                 `tfsdk:"id"`
+	MerchantID         types.String                 `tfsdk:"merchant_id"`
+	Type               types.String                 `tfsdk:"type"`
+	BusinessLineID     types.String                 `tfsdk:"business_line_id"`
+	Reference          types.String                 `tfsdk:"reference"`
+	ShopperInteraction types.String                 `tfsdk:"shopper_interaction"`
+	StoreIDs           types.Set                    `tfsdk:"store_ids"`
+	Countries          types.Set                    `tfsdk:"countries"`
+	Currencies         types.Set                    `tfsdk:"currencies"`
+	CustomRoutingFlags types.Set                    `tfsdk:"custom_routing_flags"`
+	Enabled            types.Bool                   `tfsdk:"enabled"`
+	Allowed            types.Bool                   `tfsdk:"allowed"`
+	VerificationStatus types.String                 `tfsdk:"verification_status"`
+	ApplePay           *paymentMethodApplePayModel  `tfsdk:"apple_pay"`
+	GooglePay          *paymentMethodGooglePayModel `tfsdk:"google_pay"`
+	Paypal             *paymentMethodPaypalModel    `tfsdk:"paypal"`
+	Klarna             *paymentMethodKlarnaModel    `tfsdk:"klarna"`
+	Swish              *paymentMethodSwishModel     `tfsdk:"swish"`
+	Sofort             *paymentMethodSofortModel    `tfsdk:"sofort"`
+	Bcmc               *paymentMethodBcmcModel      `tfsdk:"bcmc"`
+	Timeouts           timeouts.Value               `tfsdk:"timeouts"`
+}
+
+// paymentMethodApplePayModel maps the Apple Pay settings of a payment method.
+type paymentMethodApplePayModel struct {
+	Domains types.Set `tfsdk:"domains"`
+}
+
+// paymentMethodGooglePayModel maps the Google Pay settings of a payment method.
+type paymentMethodGooglePayModel struct {
+	MerchantID      types.String `tfsdk:"merchant_id"`
+	ReuseMerchantID types.Bool   `tfsdk:"reuse_merchant_id"`
+}
+
+// paymentMethodPaypalModel maps the PayPal settings of a payment method.
+type paymentMethodPaypalModel struct {
+	PayerID       types.String `tfsdk:"payer_id"`
+	Subject       types.String `tfsdk:"subject"`
+	DirectCapture types.Bool   `tfsdk:"direct_capture"`
+}
+
+// paymentMethodKlarnaModel maps the Klarna settings of a payment method.
+type paymentMethodKlarnaModel struct {
+	Region       types.String `tfsdk:"region"`
+	SupportEmail types.String `tfsdk:"support_email"`
+	DisputeEmail types.String `tfsdk:"dispute_email"`
+	AutoCapture  types.Bool   `tfsdk:"auto_capture"`
+}
+
+// paymentMethodSwishModel maps the Swish settings of a payment method.
+type paymentMethodSwishModel struct {
+	SwishNumber types.String `tfsdk:"swish_number"`
+}
+
+// paymentMethodSofortModel maps the Sofort settings of a payment method.
+type paymentMethodSofortModel struct {
+	CurrencyCode types.String `tfsdk:"currency_code"`
+	Logo         types.String `tfsdk:"logo"`
+}
+
+// paymentMethodBcmcModel maps the Bancontact settings of a payment method.
+type paymentMethodBcmcModel struct {
+	EnableBcmcMobile types.Bool `tfsdk:"enable_bcmc_mobile"`
+}
+
+// mapPaymentMethodResourceModel maps a payment method returned by the Adyen API to its Terraform model.
+// The type-specific settings are taken from Adyen, so changes made outside of Terraform show up as drift. Settings Adyen
+// does not echo back keep the value of the current model, see refreshEchoedString and refreshEchoedBool.
+func mapPaymentMethodResourceModel(merchantID string, paymentMethod management.PaymentMethod, current paymentMethodResourceModel) paymentMethodResourceModel {
+	model := paymentMethodResourceModel{
+		ID:                 types.StringValue(paymentMethod.Id),
+		MerchantID:         types.StringValue(merchantID),
+		Type:               types.StringPointerValue(paymentMethod.Type),
+		BusinessLineID:     types.StringPointerValue(paymentMethod.BusinessLineId),
+		Reference:          types.StringPointerValue(paymentMethod.Reference),
+		ShopperInteraction: types.StringPointerValue(paymentMethod.ShopperInteraction),
+		StoreIDs:           mapStringsToOptionalSet(paymentMethod.StoreIds, current.StoreIDs),
+		Countries:          mapStringsToOptionalSet(paymentMethod.Countries, current.Countries),
+		Currencies:         mapStringsToOptionalSet(paymentMethod.Currencies, current.Currencies),
+		CustomRoutingFlags: mapStringsToOptionalSet(paymentMethod.CustomRoutingFlags, current.CustomRoutingFlags),
+		Enabled:            types.BoolPointerValue(paymentMethod.Enabled),
+		Allowed:            types.BoolPointerValue(paymentMethod.Allowed),
+		VerificationStatus: types.StringPointerValue(paymentMethod.VerificationStatus),
+		ApplePay:           current.ApplePay,
+		GooglePay:          current.GooglePay,
+		Paypal:             current.Paypal,
+		Klarna:             current.Klarna,
+		Swish:              current.Swish,
+		Sofort:             current.Sofort,
+		Bcmc:               current.Bcmc,
+		Timeouts:           current.Timeouts,
+	}
+	if applePay := paymentMethod.ApplePay; applePay != nil {
+		// More domains can be registered with adyen_payment_method_apple_pay_domains, so only the configured domains are compared.
+		domains := applePay.Domains
+		if current.ApplePay != nil {
+			domains = []string{}
+			for _, element := range current.ApplePay.Domains.Elements() {
+				if domain, ok := element.(types.String); ok && slices.Contains(applePay.Domains, domain.ValueString()) {
+					domains = append(domains, domain.ValueString())
+				}
+			}
+		}
+		model.ApplePay = &paymentMethodApplePayModel{Domains: mapStringsToSet(domains)}
+	}
+	if googlePay := paymentMethod.GooglePay; googlePay != nil {
+		currentGooglePay := paymentMethodGooglePayModel{}
+		if current.GooglePay != nil {
+			currentGooglePay = *current.GooglePay
+		}
+		model.GooglePay = &paymentMethodGooglePayModel{
+			MerchantID:      refreshEchoedString(currentGooglePay.MerchantID, googlePay.MerchantId),
+			ReuseMerchantID: refreshEchoedBool(currentGooglePay.ReuseMerchantID, googlePay.ReuseMerchantId),
+		}
+	}
+	if paypal := paymentMethod.Paypal; paypal != nil {
+		currentPaypal := paymentMethodPaypalModel{}
+		if current.Paypal != nil {
+			currentPaypal = *current.Paypal
+		}
+		model.Paypal = &paymentMethodPaypalModel{
+			PayerID:       refreshEchoedString(currentPaypal.PayerID, paypal.PayerId),
+			Subject:       refreshEchoedString(currentPaypal.Subject, paypal.Subject),
+			DirectCapture: refreshEchoedBool(currentPaypal.DirectCapture, paypal.DirectCapture),
+		}
+	}
+	if klarna := paymentMethod.Klarna; klarna != nil {
+		currentKlarna := paymentMethodKlarnaModel{}
+		if current.Klarna != nil {
+			currentKlarna = *current.Klarna
+		}
+		model.Klarna = &paymentMethodKlarnaModel{
+			Region:       refreshEchoedString(currentKlarna.Region, klarna.Region),
+			SupportEmail: refreshEchoedString(currentKlarna.SupportEmail, klarna.SupportEmail),
+			DisputeEmail: refreshEchoedString(currentKlarna.DisputeEmail, klarna.DisputeEmail),
+			AutoCapture:  refreshEchoedBool(currentKlarna.AutoCapture, klarna.AutoCapture),
+		}
+	}
+	if swish := paymentMethod.Swish; swish != nil {
+		currentSwish := paymentMethodSwishModel{}
+		if current.Swish != nil {
+			currentSwish = *current.Swish
+		}
+		model.Swish = &paymentMethodSwishModel{SwishNumber: refreshEchoedString(currentSwish.SwishNumber, swish.SwishNumber)}
+	}
+	if sofort := paymentMethod.Sofort; sofort != nil {
+		currentSofort := paymentMethodSofortModel{}
+		if current.Sofort != nil {
+			currentSofort = *current.Sofort
+		}
+		model.Sofort = &paymentMethodSofortModel{
+			CurrencyCode: refreshEchoedString(currentSofort.CurrencyCode, sofort.CurrencyCode),
+			Logo:         refreshEchoedString(currentSofort.Logo, sofort.Logo),
+		}
+	}
+	if bcmc := paymentMethod.Bcmc; bcmc != nil {
+		currentBcmc := paymentMethodBcmcModel{}
+		if current.Bcmc != nil {
+			currentBcmc = *current.Bcmc
+		}
+		model.Bcmc = &paymentMethodBcmcModel{EnableBcmcMobile: refreshEchoedBool(currentBcmc.EnableBcmcMobile, bcmc.EnableBcmcMobile)}
+	}
+	return model
+}
+
+// refreshEchoedString returns the value Adyen has for a type-specific setting, or the current value if Adyen does not return it.
+func refreshEchoedString(current types.String, value string) types.String {
+	if value == "" {
+		return current
+	}
+	return types.StringValue(value)
+}
+
+// refreshEchoedBool returns the value Adyen has for an optional type-specific flag, or the current value if Adyen does not return it.
+// A flag that is not configured stays null while Adyen reports it as false, which is what it defaults to.
+func refreshEchoedBool(current types.Bool, value *bool) types.Bool {
+	if value == nil || (current.IsNull() && !*value) {
+		return current
+	}
+	return types.BoolValue(*value)
+}
+
+// mapStringsToOptionalSet maps a list returned by the Adyen API to an optional set attribute, keeping a set that is not configured
+// null as long as Adyen has no values for it.
+func mapStringsToOptionalSet(values []string, current types.Set) types.Set {
+	if len(values) == 0 && current.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+	return mapStringsToSet(values)
+}
+
+// mapPaymentMethodBcmcRequest maps the Bancontact settings of a payment method to an Adyen API request.
+func mapPaymentMethodBcmcRequest(bcmc *paymentMethodBcmcModel) *management.BcmcInfo {
+	if bcmc == nil {
+		return nil
+	}
+	return &management.BcmcInfo{EnableBcmcMobile: bcmc.EnableBcmcMobile.ValueBoolPointer()}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *paymentMethodResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *paymentMethodResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payment_method"
+}
+
+// Schema defines the schema for the resource.
+func (r *paymentMethodResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of a payment method of a merchant account. Some payment methods must be approved by Adyen first; " +
+			"apply waits until the verification status of such a payment method is no longer pending, for 30 minutes unless the create or update timeout is set.\n\n" +
+			"Adyen does not allow deleting payment methods, so destroying this resource disables the payment method instead. " +
+			"For the same reason the type, merchant account, business line, reference, sales channel and type-specific settings other than bcmc cannot be changed; " +
+			"run terraform apply with -replace on this resource to request a new payment method instead.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Payment methods read and write",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the payment method.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"merchant_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the merchant account. Defaults to the merchant account of the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The payment method variant, e.g. visa, mc, applepay, googlepay, paypal or klarna.",
+			},
+			"business_line_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the business line. Required if you have a platform setup.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reference": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Your reference for the payment method. Supported characters a-z, A-Z, 0-9.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shopper_interaction": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The sales channel: eCommerce, pos, contAuth or moto. Defaults to the sales channel of the merchant account.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(paymentMethodShopperInteractions...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"store_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The unique identifiers of the stores to configure the payment method for.",
+			},
+			"countries": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The countries where the payment method is available. Defaults to all countries supported by the payment method.",
+			},
+			"currencies": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The currencies the payment method supports. Defaults to all currencies supported by the payment method.",
+			},
+			"custom_routing_flags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The custom routing flags to route payments to the intended acquirer.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether the payment method is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates whether receiving payments is allowed. Set by Adyen after screening the merchant account.",
+			},
+			"verification_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The verification status of the payment method: valid, pending, invalid or rejected.",
+			},
+			"apple_pay": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Apple Pay settings, for the applepay type. Use adyen_payment_method_apple_pay_domains to register more domains later on.",
+				Attributes: map[string]schema.Attribute{
+					"domains": schema.SetAttribute{
+						Required:    true,
+						ElementType: types.StringType,
+						Description: "The merchant domains to register for Apple Pay.",
+					},
+				},
+			},
+			"google_pay": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Google Pay settings, for the googlepay type.",
+				Attributes: map[string]schema.Attribute{
+					"merchant_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The Google Pay merchant ID.",
+					},
+					"reuse_merchant_id": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Indicates whether the Google Pay merchant ID is used for several merchant accounts.",
+					},
+				},
+			},
+			"paypal": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "PayPal settings, for the paypal type.",
+				Attributes: map[string]schema.Attribute{
+					"payer_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The PayPal merchant ID.",
+					},
+					"subject": schema.StringAttribute{
+						Required:    true,
+						Description: "Your business email address.",
+					},
+					"direct_capture": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Indicates whether PayPal payments are captured immediately, overriding the capture settings of the merchant account.",
+					},
+				},
+			},
+			"klarna": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Klarna settings, for the klarna, klarna_account and klarna_paynow types.",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Required:    true,
+						Description: "The region of operation, e.g. NA, EU, CH or AU.",
+					},
+					"support_email": schema.StringAttribute{
+						Required:    true,
+						Description: "The email address of merchant support.",
+					},
+					"dispute_email": schema.StringAttribute{
+						Required:    true,
+						Description: "The email address for disputes.",
+					},
+					"auto_capture": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Indicates whether Klarna payments are captured automatically.",
+					},
+				},
+			},
+			"swish": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Swish settings, for the swish type.",
+				Attributes: map[string]schema.Attribute{
+					"swish_number": schema.StringAttribute{
+						Required:    true,
+						Description: "The Swish number: 10 digits without spaces.",
+					},
+				},
+			},
+			"sofort": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Sofort settings, for the directEbanking type.",
+				Attributes: map[string]schema.Attribute{
+					"currency_code": schema.StringAttribute{
+						Required:    true,
+						Description: "The Sofort currency code, e.g. EUR.",
+					},
+					"logo": schema.StringAttribute{
+						Required:    true,
+						Description: "The Sofort logo, base64-encoded.",
+					},
+				},
+			},
+			"bcmc": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Bancontact settings, for the bcmc type.",
+				Attributes: map[string]schema.Attribute{
+					"enable_bcmc_mobile": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Indicates whether Bancontact mobile is enabled.",
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan rejects changes to the attributes Adyen does not allow updating, as replacing the resource would leave a disabled payment method behind.
+func (r *paymentMethodResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the payment method is created or removed from state.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	for _, name := range []string{"merchant_id", "type", "business_line_id", "reference", "shopper_interaction"} {
+		var planned, current types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planned)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &current)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !planned.IsUnknown() && !planned.Equal(current) {
+			addPaymentMethodCannotBeUpdatedError(resp, id.ValueString(), name)
+		}
+	}
+
+	// The bcmc settings can be updated, every other type-specific block cannot.
+	for _, name := range []string{"apple_pay", "google_pay", "paypal", "klarna", "swish", "sofort"} {
+		var planned, current types.Object
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planned)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &current)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if paymentMethodSettingsChanged(planned, current) {
+			addPaymentMethodCannotBeUpdatedError(resp, id.ValueString(), name)
+		}
+	}
+}
+
+// paymentMethodSettingsChanged reports whether the planned type-specific settings differ from the current ones. Settings that
+// are null in the current state are not returned by Adyen, for example after an import, so they are taken from the plan.
+func paymentMethodSettingsChanged(planned types.Object, current types.Object) bool {
+	if planned.IsUnknown() || planned.Equal(current) {
+		return false
+	}
+	if planned.IsNull() || current.IsNull() {
+		return true
+	}
+	plannedAttributes := planned.Attributes()
+	for name, value := range current.Attributes() {
+		if plannedValue := plannedAttributes[name]; !value.IsNull() && !plannedValue.IsUnknown() && !value.Equal(plannedValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// addPaymentMethodCannotBeUpdatedError reports a planned change to an attribute of a payment method that Adyen does not allow updating.
+func addPaymentMethodCannotBeUpdatedError(resp *resource.ModifyPlanResponse, id string, name string) {
+	resp.Diagnostics.AddAttributeError(
+		path.Root(name),
+		"Payment Method Cannot Be Updated",
+		fmt.Sprintf("Adyen does not allow changing the %s of payment method %s. Restore the previous value, or run terraform apply with -replace on this resource "+
+			"to request a new payment method; the current payment method is then disabled, as Adyen does not allow deleting payment methods.", name, id),
+	)
+}
+
+// waitForApproval polls a payment method until Adyen has reviewed it, and fails if Adyen did not approve it.
+func (r *paymentMethodResource) waitForApproval(ctx context.Context, merchantID string, paymentMethod management.PaymentMethod, timeout time.Duration) (management.PaymentMethod, error) {
+	deadline := time.Now().Add(timeout)
+	for paymentMethod.GetVerificationStatus() == "pending" {
+		if !time.Now().Before(deadline) {
+			return paymentMethod, fmt.Errorf("payment method %s is still pending approval after %s", paymentMethod.Id, timeout)
+		}
+
+		tflog.Debug(ctx, "Waiting for adyen payment method approval", map[string]interface{}{"id": paymentMethod.Id})
+		getPaymentMethodDetailsInput := r.client.Management().PaymentMethodsMerchantLevelApi.GetPaymentMethodDetailsInput(merchantID, paymentMethod.Id)
+		refreshed, _, err := r.client.Management().PaymentMethodsMerchantLevelApi.GetPaymentMethodDetails(ctx, getPaymentMethodDetailsInput)
+		if err != nil {
+			return paymentMethod, err
+		}
+		paymentMethod = refreshed
+		if paymentMethod.GetVerificationStatus() != "pending" {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return paymentMethod, ctx.Err()
+		case <-time.After(min(paymentMethodApprovalPollInterval, time.Until(deadline))):
+		}
+	}
+
+	switch status := paymentMethod.GetVerificationStatus(); status {
+	case "invalid", "rejected":
+		return paymentMethod, fmt.Errorf("payment method %s was not approved by Adyen, its verification status is %s", paymentMethod.Id, status)
+	}
+	return paymentMethod, nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *paymentMethodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen payment method")
+
+	// Retrieve values from the plan
+	var plan paymentMethodResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	merchantID := r.client.GetConfig().MerchantAccount
+	if value := knownStringPointer(plan.MerchantID); value != nil {
+		merchantID = *value
+	}
+
+	// Generate API request body from plan
+	paymentMethodSetupInfo := management.PaymentMethodSetupInfo{
+		Type:               plan.Type.ValueString(),
+		BusinessLineId:     plan.BusinessLineID.ValueStringPointer(),
+		Reference:          plan.Reference.ValueStringPointer(),
+		ShopperInteraction: knownStringPointer(plan.ShopperInteraction),
+		Bcmc:               mapPaymentMethodBcmcRequest(plan.Bcmc),
+	}
+	for _, set := range []struct {
+		value  types.Set
+		target *[]string
+	}{
+		{plan.StoreIDs, &paymentMethodSetupInfo.StoreIds},
+		{plan.Countries, &paymentMethodSetupInfo.Countries},
+		{plan.Currencies, &paymentMethodSetupInfo.Currencies},
+		{plan.CustomRoutingFlags, &paymentMethodSetupInfo.CustomRoutingFlags},
+	} {
+		values, diags := mapSetToStrings(ctx, set.value)
+		resp.Diagnostics.Append(diags...)
+		*set.target = values
+	}
+	if plan.ApplePay != nil {
+		domains, diags := mapSetToStrings(ctx, plan.ApplePay.Domains)
+		resp.Diagnostics.Append(diags...)
+		paymentMethodSetupInfo.ApplePay = &management.ApplePayInfo{Domains: domains}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.GooglePay != nil {
+		paymentMethodSetupInfo.GooglePay = &management.GooglePayInfo{
+			MerchantId:      plan.GooglePay.MerchantID.ValueString(),
+			ReuseMerchantId: plan.GooglePay.ReuseMerchantID.ValueBoolPointer(),
+		}
+	}
+	if plan.Paypal != nil {
+		paymentMethodSetupInfo.Paypal = &management.PayPalInfo{
+			PayerId:       plan.Paypal.PayerID.ValueString(),
+			Subject:       plan.Paypal.Subject.ValueString(),
+			DirectCapture: plan.Paypal.DirectCapture.ValueBoolPointer(),
+		}
+	}
+	if plan.Klarna != nil {
+		paymentMethodSetupInfo.Klarna = &management.KlarnaInfo{
+			Region:       plan.Klarna.Region.ValueString(),
+			SupportEmail: plan.Klarna.SupportEmail.ValueString(),
+			DisputeEmail: plan.Klarna.DisputeEmail.ValueString(),
+			AutoCapture:  plan.Klarna.AutoCapture.ValueBoolPointer(),
+		}
+	}
+	if plan.Swish != nil {
+		paymentMethodSetupInfo.Swish = &management.SwishInfo{SwishNumber: plan.Swish.SwishNumber.ValueString()}
+	}
+	if plan.Sofort != nil {
+		paymentMethodSetupInfo.Sofort = &management.SofortInfo{
+			CurrencyCode: plan.Sofort.CurrencyCode.ValueString(),
+			Logo:         plan.Sofort.Logo.ValueString(),
+		}
+	}
+
+	requestPaymentMethodInput := r.client.Management().PaymentMethodsMerchantLevelApi.RequestPaymentMethodInput(merchantID).PaymentMethodSetupInfo(paymentMethodSetupInfo)
+	paymentMethod, _, err := r.client.Management().PaymentMethodsMerchantLevelApi.RequestPaymentMethod(ctx, requestPaymentMethodInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating payment method",
+			"Could not create payment method, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set the requested enabled flag right away, as it is not part of the request.
+	if enabled := knownBoolPointer(plan.Enabled); enabled != nil && *enabled != paymentMethod.GetEnabled() {
+		paymentMethodID := paymentMethod.Id
+		updatePaymentMethodInput := r.client.Management().PaymentMethodsMerchantLevelApi.UpdatePaymentMethodInput(merchantID, paymentMethodID).UpdatePaymentMethodInfo(management.UpdatePaymentMethodInfo{Enabled: enabled})
+		paymentMethod, _, err = r.client.Management().PaymentMethodsMerchantLevelApi.UpdatePaymentMethod(ctx, updatePaymentMethodInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating payment method",
+				"Could not set the enabled flag of payment method "+paymentMethodID+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, paymentMethodApprovalTimeout)
+	resp.Diagnostics.Append(diags...)
+	paymentMethod, approvalErr := r.waitForApproval(ctx, merchantID, paymentMethod, createTimeout)
+
+	// Map response body to schema and populate Computed attribute values
+	plan = mapPaymentMethodResourceModel(merchantID, paymentMethod, plan)
+
+	// Set state with the fully populated payment method, also when it was not approved, so Terraform can taint it.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if approvalErr != nil {
+		resp.Diagnostics.AddError(
+			"Error creating payment method",
+			"Could not get payment method "+paymentMethod.Id+" approved: "+approvalErr.Error(),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *paymentMethodResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state paymentMethodResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getPaymentMethodDetailsInput := r.client.Management().PaymentMethodsMerchantLevelApi.GetPaymentMethodDetailsInput(state.MerchantID.ValueString(), state.ID.ValueString())
+	paymentMethod, httpRes, err := r.client.Management().PaymentMethodsMerchantLevelApi.GetPaymentMethodDetails(ctx, getPaymentMethodDetailsInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the payment method does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Payment Method",
+			"Could not read payment method "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = mapPaymentMethodResourceModel(state.MerchantID.ValueString(), paymentMethod, state)
+
+	tflog.Debug(ctx, "Reading payment method...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *paymentMethodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating adyen payment method")
+
+	// Retrieve values from the plan
+	var plan paymentMethodResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updatePaymentMethodInfo := management.UpdatePaymentMethodInfo{
+		Enabled: knownBoolPointer(plan.Enabled),
+		Bcmc:    mapPaymentMethodBcmcRequest(plan.Bcmc),
+	}
+	for _, set := range []struct {
+		value  types.Set
+		target *[]string
+	}{
+		{plan.StoreIDs, &updatePaymentMethodInfo.StoreIds},
+		{plan.Countries, &updatePaymentMethodInfo.Countries},
+		{plan.Currencies, &updatePaymentMethodInfo.Currencies},
+		{plan.CustomRoutingFlags, &updatePaymentMethodInfo.CustomRoutingFlags},
+	} {
+		// Adyen keeps the values of a list that is left out, so an explicit empty list clears the values that were removed from the configuration.
+		values, diags := mapSetToStrings(ctx, set.value)
+		resp.Diagnostics.Append(diags...)
+		if values == nil {
+			values = []string{}
+		}
+		*set.target = values
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	merchantID := plan.MerchantID.ValueString()
+	updatePaymentMethodInput := r.client.Management().PaymentMethodsMerchantLevelApi.UpdatePaymentMethodInput(merchantID, plan.ID.ValueString()).UpdatePaymentMethodInfo(updatePaymentMethodInfo)
+	paymentMethod, _, err := r.client.Management().PaymentMethodsMerchantLevelApi.UpdatePaymentMethod(ctx, updatePaymentMethodInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating payment method",
+			"Could not update payment method "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, paymentMethodApprovalTimeout)
+	resp.Diagnostics.Append(diags...)
+	paymentMethod, approvalErr := r.waitForApproval(ctx, merchantID, paymentMethod, updateTimeout)
+
+	// Map response body to schema and populate Computed attribute values
+	plan = mapPaymentMethodResourceModel(merchantID, paymentMethod, plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if approvalErr != nil {
+		resp.Diagnostics.AddError(
+			"Error updating payment method",
+			"Could not get payment method "+paymentMethod.Id+" approved: "+approvalErr.Error(),
+		)
+	}
+}
+
+// Delete disables the payment method and removes the Terraform state on success, as Adyen does not allow deleting payment methods.
+func (r *paymentMethodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state paymentMethodResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatePaymentMethodInput := r.client.Management().PaymentMethodsMerchantLevelApi.UpdatePaymentMethodInput(state.MerchantID.ValueString(), state.ID.ValueString()).UpdatePaymentMethodInfo(management.UpdatePaymentMethodInfo{Enabled: common.PtrBool(false)})
+	_, httpRes, err := r.client.Management().PaymentMethodsMerchantLevelApi.UpdatePaymentMethod(ctx, updatePaymentMethodInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // The payment method no longer exists, so there is nothing to disable.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Payment Method",
+			"Could not disable payment method "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing payment method using its merchant account and identifier.
+func (r *paymentMethodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	merchantID, paymentMethodID, err := splitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import identifier of the form '<merchant_id>/<payment_method_id>': "+err.Error(),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the id attribute, Read fills in the remaining state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("merchant_id"), merchantID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), paymentMethodID)...)
+}
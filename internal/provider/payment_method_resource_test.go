@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func testAccCheckAdyenPaymentMethodDestroy(tfstate *terraform.State) error {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	for _, rs := range tfstate.RootModule().Resources {
+		if rs.Type != "adyen_payment_method" {
+			continue
+		}
+
+		// Adyen does not allow deleting payment methods, so destroying the resource disables the payment method.
+		data := client.Management().PaymentMethodsMerchantLevelApi.GetPaymentMethodDetailsInput(rs.Primary.Attributes["merchant_id"], rs.Primary.ID)
+		paymentMethod, resp, err := client.Management().PaymentMethodsMerchantLevelApi.GetPaymentMethodDetails(context.Background(), data)
+		if resp != nil && resp.StatusCode == 422 { // 422 Unprocessable Entity error code from Adyen if resource does not exist.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if paymentMethod.GetEnabled() {
+			return fmt.Errorf("adyen_payment_method with id: '%s' is still enabled", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccPaymentMethodResource(t *testing.T) {
+	resourceName := "adyen_payment_method.test"
+	var paymentMethodID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenPaymentMethodDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigPaymentMethod(`["NL"]`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "merchant_id", "WeaveAccountECOM"),
+					resource.TestCheckResourceAttr(resourceName, "type", "paypal"),
+					resource.TestCheckResourceAttr(resourceName, "shopper_interaction", "eCommerce"),
+					resource.TestCheckResourceAttr(resourceName, "countries.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "currencies.*", "EUR"),
+					resource.TestCheckResourceAttr(resourceName, "paypal.payer_id", "ABCDEFGHIJKLM"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					// Apply waits until Adyen has approved the payment method.
+					resource.TestCheckResourceAttr(resourceName, "verification_status", "valid"),
+					resource.TestCheckResourceAttr(resourceName, "allowed", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, "store_ids"),
+					func(s *terraform.State) error {
+						paymentMethodID = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+					return rs.Primary.Attributes["merchant_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigPaymentMethod(`["NL", "BE"]`, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "countries.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "countries.*", "BE"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				// Removing a list from the configuration clears it.
+				Config: testProviderClientFromTmpl(t) + testConfigPaymentMethod("", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckNoResourceAttr(resourceName, "countries"),
+			},
+			{
+				// Adyen cannot update the type-specific settings, and replacing the payment method would leave the old one behind.
+				Config:      testProviderClientFromTmpl(t) + strings.Replace(testConfigPaymentMethod("", false), "payments@example.com", "finance@example.com", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Adyen does not allow changing the paypal of payment method`),
+			},
+			{
+				// Changing the type-specific settings outside of Terraform must show up as drift, which is rejected the same way.
+				SkipFunc: func() (bool, error) {
+					return testMockServer == nil, nil
+				},
+				PreConfig: func() {
+					testMockServer.changePaymentMethod(paymentMethodID, func(paymentMethod *management.PaymentMethod) {
+						paymentMethod.Paypal.Subject = "finance@example.com"
+					})
+				},
+				Config:      testProviderClientFromTmpl(t) + testConfigPaymentMethod("", false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Adyen does not allow changing the paypal of payment method`),
+			},
+		},
+	})
+}
+
+func TestAccPaymentMethodResourceApproval(t *testing.T) {
+	mockOnly := func() (bool, error) {
+		return testMockServer == nil, nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenPaymentMethodDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				SkipFunc:    mockOnly,
+				Config:      testProviderClientFromTmpl(t) + testConfigPaymentMethodReview(mockPaymentMethodRejectedReference),
+				ExpectError: regexp.MustCompile(`was not approved by Adyen, its verification status is\s+rejected`),
+			},
+			{
+				SkipFunc:    mockOnly,
+				Config:      testProviderClientFromTmpl(t) + testConfigPaymentMethodReview(mockPaymentMethodPendingReference),
+				ExpectError: regexp.MustCompile(`is still pending approval after 1s`),
+			},
+		},
+	})
+}
+
+func testConfigPaymentMethodReview(reference string) string {
+	return fmt.Sprintf(`
+	resource "adyen_payment_method" "review" {
+		type      = "swish"
+		reference = "%s"
+		swish = {
+			swish_number = "1231111111"
+		}
+
+		timeouts {
+			create = "1s"
+		}
+	}
+`, reference)
+}
+
+// testConfigPaymentMethod configures a PayPal payment method, leaving out countries if it is empty.
+func testConfigPaymentMethod(countries string, enabled bool) string {
+	if countries != "" {
+		countries = "countries  = " + countries
+	}
+	return fmt.Sprintf(`
+	resource "adyen_payment_method" "test" {
+		type       = "paypal"
+		%s
+		currencies = ["EUR"]
+		enabled    = %t
+		paypal = {
+			payer_id       = "ABCDEFGHIJKLM"
+			subject        = "payments@example.com"
+			direct_capture = true
+		}
+	}
+`, countries, enabled)
+}
@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &payoutSettingResource{}
+	_ resource.ResourceWithConfigure   = &payoutSettingResource{}
+	_ resource.ResourceWithImportState = &payoutSettingResource{}
+	_ resource.ResourceWithModifyPlan  = &payoutSettingResource{}
+)
+
+// payoutSettingImportedKey is the private state key that marks a payout setting that was just imported,
+// so the first read takes the enabled from date from Adyen instead of the configuration.
+const payoutSettingImportedKey = "imported"
+
+// payoutSettingResource is the resource implementation.
+type payoutSettingResource struct {
+	client *adyen.APIClient
+}
+
+// NewPayoutSettingResource is a helper function to simplify the provider implementation.
+func NewPayoutSettingResource() resource.Resource {
+	return &payoutSettingResource{}
+}
+
+// payoutSettingResourceModel maps the "payout_setting" schema data for a resource.
+type payoutSettingResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	MerchantID           types.String `tfsdk:"merchant_id"`
+	TransferInstrumentID types.String `tfsdk:"transfer_instrument_id"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	EnabledFromDate      types.String `tfsdk:"enabled_from_date"`
+	Allowed              types.Bool   `tfsdk:"allowed"`
+	Priority             types.String `tfsdk:"priority"`
+	VerificationStatus   types.String `tfsdk:"verification_status"`
+}
+
+// mapPayoutSettingResourceModel maps a payout setting returned by the Adyen API to its Terraform model.
+// Adyen clears the enabled from date once it has passed, so the configured date is kept, and left null when it is not configured.
+func mapPayoutSettingResourceModel(merchantID string, payoutSettings management.PayoutSettings, current payoutSettingResourceModel) payoutSettingResourceModel {
+	model := payoutSettingResourceModel{
+		ID:                   types.StringValue(payoutSettings.Id),
+		MerchantID:           types.StringValue(merchantID),
+		TransferInstrumentID: types.StringValue(payoutSettings.TransferInstrumentId),
+		Enabled:              types.BoolPointerValue(payoutSettings.Enabled),
+		EnabledFromDate:      current.EnabledFromDate,
+		Allowed:              types.BoolPointerValue(payoutSettings.Allowed),
+		Priority:             types.StringPointerValue(payoutSettings.Priority),
+		VerificationStatus:   types.StringPointerValue(payoutSettings.VerificationStatus),
+	}
+	return model
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *payoutSettingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *payoutSettingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payout_setting"
+}
+
+// Schema defines the schema for the resource.
+func (r *payoutSettingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Links a transfer instrument, i.e. a bank account, to a merchant account to receive payouts. Adyen verifies the bank account; " +
+			"payouts are only made when both enabled and allowed are true. Adyen can only update enabled, so changing enabled_from_date fails during plan.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Payout account settings read and write",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the payout setting.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"merchant_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the merchant account. Defaults to the merchant account of the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"transfer_instrument_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the transfer instrument that contains the details of the bank account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether payouts to the bank account are enabled. Defaults to true.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled_from_date": schema.StringAttribute{
+				Optional: true,
+				Description: "The date when Adyen starts paying out to the bank account, in ISO 8601 format, e.g. 2019-11-23T12:25:28Z. " +
+					"If enabled is false, payouts are disabled until this date. Adyen clears the date once it has passed, the configured value is kept in the state. " +
+					"Adyen cannot change the date of an existing payout setting, which would require deleting it and adding it again, so that the bank account has to be verified again. " +
+					"To do so anyway, run terraform apply with -replace.",
+			},
+			"allowed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates whether payouts to the bank account are allowed. True when the verification status is valid.",
+			},
+			"priority": schema.StringAttribute{
+				Computed:    true,
+				Description: "How long it takes for the funds to reach the bank account: first (same day), urgent (next day) or normal (between 1 and 3 days).",
+			},
+			"verification_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the verification of the bank account: valid, pending, invalid or rejected.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *payoutSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen payout setting")
+
+	// Retrieve values from the plan
+	var plan payoutSettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	merchantID := r.client.GetConfig().MerchantAccount
+	if value := knownStringPointer(plan.MerchantID); value != nil {
+		merchantID = *value
+	}
+
+	// Generate API request body from plan
+	payoutSettingsRequest := management.PayoutSettingsRequest{
+		TransferInstrumentId: plan.TransferInstrumentID.ValueString(),
+		Enabled:              knownBoolPointer(plan.Enabled),
+		EnabledFromDate:      plan.EnabledFromDate.ValueStringPointer(),
+	}
+
+	addPayoutSettingInput := r.client.Management().PayoutSettingsMerchantLevelApi.AddPayoutSettingInput(merchantID).PayoutSettingsRequest(payoutSettingsRequest)
+	payoutSettings, _, err := r.client.Management().PayoutSettingsMerchantLevelApi.AddPayoutSetting(ctx, addPayoutSettingInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating payout setting",
+			"Could not create payout setting, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan = mapPayoutSettingResourceModel(merchantID, payoutSettings, plan)
+
+	// Set state with the fully populated payout setting
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *payoutSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state payoutSettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imported, diags := req.Private.GetKey(ctx, payoutSettingImportedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getPayoutSettingInput := r.client.Management().PayoutSettingsMerchantLevelApi.GetPayoutSettingInput(state.MerchantID.ValueString(), state.ID.ValueString())
+	payoutSettings, httpRes, err := r.client.Management().PayoutSettingsMerchantLevelApi.GetPayoutSetting(ctx, getPayoutSettingInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the payout setting does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Payout Setting",
+			"Could not read payout setting "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = mapPayoutSettingResourceModel(state.MerchantID.ValueString(), payoutSettings, state)
+	if imported != nil {
+		state.EnabledFromDate = types.StringPointerValue(payoutSettings.EnabledFromDate)
+	}
+
+	tflog.Debug(ctx, "Reading payout setting...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, payoutSettingImportedKey, nil)...)
+}
+
+// ModifyPlan rejects changing the enabled from date, as Adyen cannot update it and replacing the payout setting stops payouts until the bank account is verified again.
+func (r *payoutSettingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the payout setting is added or removed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state payoutSettingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new transfer instrument replaces the payout setting anyway, and removing the date from the configuration leaves it in Adyen.
+	if !plan.TransferInstrumentID.Equal(state.TransferInstrumentID) || plan.EnabledFromDate.IsNull() || plan.EnabledFromDate.IsUnknown() || plan.EnabledFromDate.Equal(state.EnabledFromDate) {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("enabled_from_date"),
+		"Payout Setting Cannot Be Updated",
+		fmt.Sprintf("Adyen cannot change the enabled_from_date of payout setting %s. Deleting and adding the payout setting again sets the bank account back to pending verification, "+
+			"which stops payouts until it is verified. To do so anyway, run terraform apply with -replace on this resource.", state.ID.ValueString()),
+	)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *payoutSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating adyen payout setting")
+
+	// Retrieve values from the plan
+	var plan payoutSettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan, only the enabled flag can be updated.
+	updatePayoutSettingsRequest := management.UpdatePayoutSettingsRequest{
+		Enabled: knownBoolPointer(plan.Enabled),
+	}
+
+	merchantID := plan.MerchantID.ValueString()
+	updatePayoutSettingInput := r.client.Management().PayoutSettingsMerchantLevelApi.UpdatePayoutSettingInput(merchantID, plan.ID.ValueString()).UpdatePayoutSettingsRequest(updatePayoutSettingsRequest)
+	payoutSettings, _, err := r.client.Management().PayoutSettingsMerchantLevelApi.UpdatePayoutSetting(ctx, updatePayoutSettingInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating payout setting",
+			"Could not update payout setting "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan = mapPayoutSettingResourceModel(merchantID, payoutSettings, plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *payoutSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state payoutSettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deletePayoutSettingInput := r.client.Management().PayoutSettingsMerchantLevelApi.DeletePayoutSettingInput(state.MerchantID.ValueString(), state.ID.ValueString())
+	httpRes, err := r.client.Management().PayoutSettingsMerchantLevelApi.DeletePayoutSetting(ctx, deletePayoutSettingInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // The payout setting no longer exists, so there is nothing to delete.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Payout Setting",
+			"Could not delete payout setting "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing payout setting using its merchant account and identifier.
+func (r *payoutSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	merchantID, payoutSettingID, err := splitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import identifier of the form '<merchant_id>/<payout_setting_id>': "+err.Error(),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the id attribute, Read fills in the remaining state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("merchant_id"), merchantID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), payoutSettingID)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, payoutSettingImportedKey, []byte("true"))...)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func testAccCheckAdyenPayoutSettingDestroy(tfstate *terraform.State) error {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	for _, rs := range tfstate.RootModule().Resources {
+		if rs.Type != "adyen_payout_setting" {
+			continue
+		}
+
+		data := client.Management().PayoutSettingsMerchantLevelApi.GetPayoutSettingInput(rs.Primary.Attributes["merchant_id"], rs.Primary.ID)
+		_, resp, err := client.Management().PayoutSettingsMerchantLevelApi.GetPayoutSetting(context.Background(), data)
+		if resp != nil && resp.StatusCode == 422 { // 422 Unprocessable Entity error code from Adyen if resource does not exist.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("adyen_payout_setting with id: '%s' still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func TestAccPayoutSettingResource(t *testing.T) {
+	resourceName := "adyen_payout_setting.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenPayoutSettingDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigPayoutSetting(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "merchant_id", "WeaveAccountECOM"),
+					resource.TestCheckResourceAttr(resourceName, "transfer_instrument_id", "SE00000000000000000000000"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_from_date", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "normal"),
+					resource.TestCheckResourceAttr(resourceName, "verification_status", "pending"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+					return rs.Primary.Attributes["merchant_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigPayoutSetting(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				// Adyen cannot change the date, and replacing the payout setting would stop payouts until the bank account is verified again.
+				Config:      testProviderClientFromTmpl(t) + strings.Replace(testConfigPayoutSetting(true), "2030-01-01", "2031-01-01", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Adyen cannot change the enabled_from_date of payout setting`),
+			},
+			{
+				// Removing the date from the configuration leaves it in Adyen, without showing up as drift afterwards.
+				Config: testProviderClientFromTmpl(t) + strings.Replace(testConfigPayoutSetting(true), `enabled_from_date      = "2030-01-01T00:00:00Z"`, "", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "enabled_from_date"),
+				),
+			},
+		},
+	})
+}
+
+func testConfigPayoutSetting(enabled bool) string {
+	return fmt.Sprintf(`
+	resource "adyen_payout_setting" "test" {
+		transfer_instrument_id = "SE00000000000000000000000"
+		enabled                = %t
+		enabled_from_date      = "2030-01-01T00:00:00Z"
+	}
+`, enabled)
+}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
 	"github.com/adyen/adyen-go-api-library/v9/src/common"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -15,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"os"
+	"slices"
+	"strings"
 )
 
 // Ensure adyenProvider satisfies various provider interfaces.
@@ -39,6 +42,8 @@ type adyenProviderModel struct {
 	ApiKey          types.String `tfsdk:"api_key"`
 	Environment     types.String `tfsdk:"environment"`
 	MerchantAccount types.String `tfsdk:"merchant_account"`
+	BaseURL         types.String `tfsdk:"base_url"`
+	RequiredRoles   types.Set    `tfsdk:"required_roles"`
 }
 
 // Metadata returns the provider type name.
@@ -65,6 +70,17 @@ func (p *adyenProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Sensitive:           true,
 				MarkdownDescription: "The Merchant Account ID for the Adyen API Client.",
 			},
+			"base_url": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Overrides the Management API base URL, including the API version (e.g. `https://management-test.adyen.com/v3`). " +
+					"Mostly useful to point the provider at a mock server during testing. Can also be set with the ADYEN_API_BASE_URL environment variable.",
+			},
+			"required_roles": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Roles the API credential of `api_key` must have, for example `Management API - Webhooks read and write`. " +
+					"If set, the provider looks up its own API credential when it is configured and fails early if any of these roles is missing.",
+			},
 		},
 	}
 }
@@ -107,6 +123,15 @@ func (p *adyenProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	if config.BaseURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Unknown Adyen API Base URL",
+			"The provider cannot create the Adyen API client as there is an unknown configuration value for the Adyen API Base URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ADYEN_API_BASE_URL environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -114,6 +139,7 @@ func (p *adyenProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	apiKey := os.Getenv("ADYEN_API_KEY")
 	environment := os.Getenv("ADYEN_API_ENVIRONMENT")
 	merchantAccount := os.Getenv("ADYEN_API_MERCHANT_ACCOUNT")
+	baseURL := os.Getenv("ADYEN_API_BASE_URL")
 
 	if !config.ApiKey.IsNull() {
 		apiKey = config.ApiKey.ValueString()
@@ -127,6 +153,10 @@ func (p *adyenProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		merchantAccount = config.MerchantAccount.ValueString()
 	}
 
+	if !config.BaseURL.IsNull() {
+		baseURL = config.BaseURL.ValueString()
+	}
+
 	if apiKey == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("apikey"),
@@ -174,6 +204,45 @@ func (p *adyenProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		MerchantAccount: merchantAccount,
 	})
 
+	// The Adyen client derives the Management API endpoint from the environment, so an explicit base URL has to be set afterwards.
+	if baseURL != "" {
+		client.GetConfig().ManagementEndpoint = strings.TrimSuffix(baseURL, "/")
+	}
+
+	// Fail early if the API credential lacks any of the roles the configuration needs.
+	if !config.RequiredRoles.IsNull() && !config.RequiredRoles.IsUnknown() {
+		requiredRoles, diags := mapSetToStrings(ctx, config.RequiredRoles)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		getApiCredentialDetailsInput := client.Management().MyAPICredentialApi.GetApiCredentialDetailsInput()
+		credential, _, err := client.Management().MyAPICredentialApi.GetApiCredentialDetails(ctx, getApiCredentialDetailsInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Check Adyen API Credential Roles",
+				"The provider could not read its API credential to check the required roles, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		var missingRoles []string
+		for _, role := range requiredRoles {
+			if !slices.Contains(credential.Roles, role) {
+				missingRoles = append(missingRoles, role)
+			}
+		}
+		if len(missingRoles) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("required_roles"),
+				"Missing Adyen API Credential Roles",
+				fmt.Sprintf("The API credential %s is missing the following required roles: %s.", credential.Username, strings.Join(missingRoles, ", ")),
+			)
+			return
+		}
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 
@@ -185,10 +254,42 @@ func (p *adyenProvider) Resources(ctx context.Context) []func() resource.Resourc
 	return []func() resource.Resource{
 		func() resource.Resource { return NewWebhooksMerchantResource() },
 		func() resource.Resource { return NewWebhooksCompanyResource() },
+		func() resource.Resource { return NewWebhookHmacKeyResource() },
+		func() resource.Resource { return NewUserMerchantResource() },
+		func() resource.Resource { return NewUserCompanyResource() },
+		func() resource.Resource { return NewApiCredentialMerchantResource() },
+		func() resource.Resource { return NewApiCredentialCompanyResource() },
+		func() resource.Resource { return NewApiCredentialApiKeyResource() },
+		func() resource.Resource { return NewApiCredentialClientKeyResource() },
+		func() resource.Resource { return NewAllowedOriginResource() },
+		func() resource.Resource { return NewMerchantAccountResource() },
+		func() resource.Resource { return NewStoreResource() },
+		func() resource.Resource { return NewPaymentMethodResource() },
+		func() resource.Resource { return NewPaymentMethodApplePayDomainsResource() },
+		func() resource.Resource { return NewPayoutSettingResource() },
+		func() resource.Resource { return NewTerminalSettingsResource() },
+		func() resource.Resource { return NewTerminalLogoResource() },
+		func() resource.Resource { return NewTerminalOrderResource() },
+		func() resource.Resource { return NewTerminalActionResource() },
+		func() resource.Resource { return NewTerminalAssignmentResource() },
 	}
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *adyenProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		func() datasource.DataSource { return NewWebhooksMerchantDataSource() },
+		func() datasource.DataSource { return NewWebhooksMerchantListDataSource() },
+		func() datasource.DataSource { return NewWebhooksCompanyDataSource() },
+		func() datasource.DataSource { return NewWebhooksCompanyListDataSource() },
+		func() datasource.DataSource { return NewWebhookTestDataSource() },
+		func() datasource.DataSource { return NewApiCredentialMeDataSource() },
+		func() datasource.DataSource { return NewMerchantAccountsDataSource() },
+		func() datasource.DataSource { return NewCompanyAccountDataSource() },
+		func() datasource.DataSource { return NewCompanyAccountsDataSource() },
+		func() datasource.DataSource { return NewStoreDataSource() },
+		func() datasource.DataSource { return NewAndroidAppsDataSource() },
+		func() datasource.DataSource { return NewAndroidCertificatesDataSource() },
+		func() datasource.DataSource { return NewTerminalsDataSource() },
+	}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/stretchr/testify/suite"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -31,6 +32,36 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"adyen": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testMockServer is the mock of the Management API the acceptance tests run against, or nil when they run against
+// an Adyen test account. Tests use it to simulate changes that cannot be made through the API, e.g. shipping an order.
+var testMockServer *mockManagementServer
+
+// TestMain runs the acceptance tests against an in-process mock of the Management API, unless ADYEN_API_KEY
+// is set. This allows running the tests offline, without credentials for an Adyen test account.
+func TestMain(m *testing.M) {
+	if os.Getenv("ADYEN_API_KEY") != "" {
+		os.Exit(m.Run())
+	}
+
+	server := newMockManagementServer()
+	testMockServer = server
+	mockEnv := map[string]string{
+		"ADYEN_API_KEY":              "mock_api_key",
+		"ADYEN_API_ENVIRONMENT":      "test",
+		"ADYEN_API_MERCHANT_ACCOUNT": "WeaveAccountECOM",
+		"ADYEN_API_BASE_URL":         server.URL(),
+	}
+	for k, v := range mockEnv {
+		if err := os.Setenv(k, v); err != nil {
+			panic("Failed to set mock environment: " + err.Error())
+		}
+	}
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
 func (s *AcceptanceSuite) SetupSuite() {
 	conf := &common.Config{
 		ApiKey:          os.Getenv("ADYEN_API_KEY"),
@@ -39,6 +70,9 @@ func (s *AcceptanceSuite) SetupSuite() {
 	}
 
 	s.client = adyen.NewClient(conf)
+	if baseURL := os.Getenv("ADYEN_API_BASE_URL"); baseURL != "" {
+		s.client.GetConfig().ManagementEndpoint = strings.TrimSuffix(baseURL, "/")
+	}
 }
 
 func testAccPreCheck(t *testing.T) {
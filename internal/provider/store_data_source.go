@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &storeDataSource{}
+	_ datasource.DataSourceWithConfigure = &storeDataSource{}
+)
+
+// storeDataSource is the data source implementation.
+type storeDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewStoreDataSource is a helper function to simplify the provider implementation.
+func NewStoreDataSource() datasource.DataSource {
+	return &storeDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *storeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *storeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_store"
+}
+
+// Schema defines the schema for the data source.
+func (d *storeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a store by its reference. Set merchant_id when stores of different merchant accounts share the same reference.\n\n" +
+			"To make this request, your API credential must have one of the following roles:\n\nManagement API—Stores read\nManagement API—Stores read and write",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the store.",
+			},
+			"merchant_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the merchant account to look up the store in. Defaults to all merchant accounts the API credential can reach.",
+			},
+			"reference": schema.StringAttribute{
+				Required:    true,
+				Description: "The reference of the store to look up. Also known as the store code.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Your description of the store.",
+			},
+			"shopper_statement": schema.StringAttribute{
+				Computed:    true,
+				Description: "The store name shown on the shopper's bank or credit card statement and on the shopper receipt.",
+			},
+			"phone_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "The phone number of the store.",
+			},
+			"external_reference_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Used by certain payment methods and tax authorities to uniquely identify the store.",
+			},
+			"address": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The address of the store.",
+				Attributes: map[string]schema.Attribute{
+					"country": schema.StringAttribute{
+						Computed:    true,
+						Description: "The two-letter country code in ISO 3166-1 alpha-2 format.",
+					},
+					"line1": schema.StringAttribute{
+						Computed:    true,
+						Description: "The street address, including the house number.",
+					},
+					"line2": schema.StringAttribute{
+						Computed:    true,
+						Description: "Second address line.",
+					},
+					"line3": schema.StringAttribute{
+						Computed:    true,
+						Description: "Third address line.",
+					},
+					"city": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the city.",
+					},
+					"postal_code": schema.StringAttribute{
+						Computed:    true,
+						Description: "The postal code.",
+					},
+					"state_or_province": schema.StringAttribute{
+						Computed:    true,
+						Description: "The state or province code as defined in ISO 3166-2.",
+					},
+				},
+			},
+			"business_line_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The unique identifiers of the business lines that the store is associated with.",
+			},
+			"split_configuration": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Rules for Adyen for Platforms merchants to split the transaction amount and fees.",
+				Attributes: map[string]schema.Attribute{
+					"balance_account_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The unique identifier of the balance account to which the split amount must be booked.",
+					},
+					"split_configuration_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The unique identifier of the split configuration profile.",
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the store: active, inactive or closed.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *storeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state storeResourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading store data source...")
+
+	reference := state.Reference.ValueString()
+	var matches []management.Store
+	for page := int32(1); ; page++ {
+		listStoresInput := d.client.Management().AccountStoreLevelApi.ListStoresInput().Reference(reference).PageNumber(page).PageSize(listPageSize)
+		if merchantID := knownStringPointer(state.MerchantID); merchantID != nil {
+			listStoresInput = listStoresInput.MerchantId(*merchantID)
+		}
+		stores, _, err := d.client.Management().AccountStoreLevelApi.ListStores(ctx, listStoresInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Store",
+				"Could not look up store "+reference+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+		for _, store := range stores.Data {
+			if store.GetReference() == reference {
+				matches = append(matches, store)
+			}
+		}
+		if page >= stores.PagesTotal {
+			break
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Error Reading Store",
+			"Could not find a store with reference "+reference+".",
+		)
+		return
+	case 1:
+	default:
+		merchantIDs := make([]string, 0, len(matches))
+		for _, store := range matches {
+			merchantIDs = append(merchantIDs, store.GetMerchantId())
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Store",
+			"Found more than one store with reference "+reference+", in merchant accounts "+strings.Join(merchantIDs, ", ")+". Set merchant_id to select one of them.",
+		)
+		return
+	}
+
+	state = mapStoreResourceModel(matches[0])
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccStoreDataSource(t *testing.T) {
+	dataSourceName := "data.adyen_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigStoreDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "adyen_store.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "merchant_id", "WeaveAccountECOM"),
+					resource.TestCheckResourceAttr(dataSourceName, "description", "Terraform lookup store"),
+					resource.TestCheckResourceAttr(dataSourceName, "shopper_statement", "Terraform Lookup"),
+					resource.TestCheckResourceAttr(dataSourceName, "address.country", "NL"),
+					resource.TestCheckResourceAttr(dataSourceName, "address.city", "Amsterdam"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "active"),
+				),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigStoreDataSource + `
+	data "adyen_store" "missing" {
+		reference = "TerraformMissingStore"
+	}
+`,
+				ExpectError: regexp.MustCompile(`Could not find a store with reference\s+TerraformMissingStore`),
+			},
+		},
+	})
+}
+
+const testConfigStoreDataSource = `
+	resource "adyen_store" "test" {
+		reference         = "TerraformLookupStore"
+		description       = "Terraform lookup store"
+		shopper_statement = "Terraform Lookup"
+		phone_number      = "+31201234567"
+		address = {
+			country = "NL"
+			city    = "Amsterdam"
+		}
+	}
+
+	data "adyen_store" "test" {
+		merchant_id = adyen_store.test.merchant_id
+		reference   = adyen_store.test.reference
+	}
+`
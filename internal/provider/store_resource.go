@@ -0,0 +1,510 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &storeResource{}
+	_ resource.ResourceWithConfigure   = &storeResource{}
+	_ resource.ResourceWithImportState = &storeResource{}
+)
+
+// Allowed values of the store status.
+var storeStatuses = []string{"active", "inactive", "closed"}
+
+// storeResource is the resource implementation.
+type storeResource struct {
+	client *adyen.APIClient
+}
+
+// NewStoreResource is a helper function to simplify the provider implementation.
+func NewStoreResource() resource.Resource {
+	return &storeResource{}
+}
+
+// storeResourceModel maps the "store" schema data for a resource.
+type storeResourceModel struct {
+	ID                  types.String                  `tfsdk:"id"`
+	MerchantID          types.String                  `tfsdk:"merchant_id"`
+	Reference           types.String                  `tfsdk:"reference"`
+	Description         types.String                  `tfsdk:"description"`
+	ShopperStatement    types.String                  `tfsdk:"shopper_statement"`
+	PhoneNumber         types.String                  `tfsdk:"phone_number"`
+	ExternalReferenceID types.String                  `tfsdk:"external_reference_id"`
+	Address             *storeAddressModel            `tfsdk:"address"`
+	BusinessLineIDs     types.Set                     `tfsdk:"business_line_ids"`
+	SplitConfiguration  *storeSplitConfigurationModel `tfsdk:"split_configuration"`
+	Status              types.String                  `tfsdk:"status"`
+}
+
+// storeAddressModel maps the address of a store.
+type storeAddressModel struct {
+	Country         types.String `tfsdk:"country"`
+	Line1           types.String `tfsdk:"line1"`
+	Line2           types.String `tfsdk:"line2"`
+	Line3           types.String `tfsdk:"line3"`
+	City            types.String `tfsdk:"city"`
+	PostalCode      types.String `tfsdk:"postal_code"`
+	StateOrProvince types.String `tfsdk:"state_or_province"`
+}
+
+// storeSplitConfigurationModel maps the split configuration of a store.
+type storeSplitConfigurationModel struct {
+	BalanceAccountID     types.String `tfsdk:"balance_account_id"`
+	SplitConfigurationID types.String `tfsdk:"split_configuration_id"`
+}
+
+// mapStoreResourceModel maps a store returned by the Adyen API to its Terraform model.
+func mapStoreResourceModel(store management.Store) storeResourceModel {
+	model := storeResourceModel{
+		ID:                  types.StringPointerValue(store.Id),
+		MerchantID:          types.StringPointerValue(store.MerchantId),
+		Reference:           types.StringPointerValue(store.Reference),
+		Description:         types.StringPointerValue(store.Description),
+		ShopperStatement:    types.StringPointerValue(store.ShopperStatement),
+		PhoneNumber:         types.StringPointerValue(store.PhoneNumber),
+		ExternalReferenceID: types.StringPointerValue(store.ExternalReferenceId),
+		BusinessLineIDs:     mapStringsToSet(store.BusinessLineIds),
+		Status:              types.StringPointerValue(store.Status),
+	}
+	if store.Address != nil {
+		model.Address = &storeAddressModel{
+			Country:         types.StringValue(store.Address.Country),
+			Line1:           mapOptionalString(store.Address.Line1),
+			Line2:           mapOptionalString(store.Address.Line2),
+			Line3:           mapOptionalString(store.Address.Line3),
+			City:            mapOptionalString(store.Address.City),
+			PostalCode:      mapOptionalString(store.Address.PostalCode),
+			StateOrProvince: mapOptionalString(store.Address.StateOrProvince),
+		}
+	}
+	if splitConfiguration := store.SplitConfiguration; splitConfiguration != nil && (splitConfiguration.GetBalanceAccountId() != "" || splitConfiguration.GetSplitConfigurationId() != "") {
+		model.SplitConfiguration = &storeSplitConfigurationModel{
+			BalanceAccountID:     mapOptionalString(splitConfiguration.BalanceAccountId),
+			SplitConfigurationID: mapOptionalString(splitConfiguration.SplitConfigurationId),
+		}
+	}
+	return model
+}
+
+// mapStoreSplitConfigurationRequest maps the split configuration of a store to an Adyen API request.
+func mapStoreSplitConfigurationRequest(splitConfiguration *storeSplitConfigurationModel) *management.StoreSplitConfiguration {
+	if splitConfiguration == nil {
+		return nil
+	}
+	return &management.StoreSplitConfiguration{
+		BalanceAccountId:     splitConfiguration.BalanceAccountID.ValueStringPointer(),
+		SplitConfigurationId: splitConfiguration.SplitConfigurationID.ValueStringPointer(),
+	}
+}
+
+// mapStoreSplitConfigurationUpdateRequest maps the split configuration of a store to an Adyen API update request. Values that are
+// removed from the configuration are sent as empty strings, so Adyen clears them instead of keeping them.
+func mapStoreSplitConfigurationUpdateRequest(plan *storeSplitConfigurationModel, state *storeSplitConfigurationModel) *management.StoreSplitConfiguration {
+	if plan == nil {
+		if state == nil {
+			return nil
+		}
+		plan = &storeSplitConfigurationModel{BalanceAccountID: types.StringNull(), SplitConfigurationID: types.StringNull()}
+	}
+	return &management.StoreSplitConfiguration{
+		BalanceAccountId:     clearableStringPointer(plan.BalanceAccountID),
+		SplitConfigurationId: clearableStringPointer(plan.SplitConfigurationID),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *storeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *storeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_store"
+}
+
+// Schema defines the schema for the resource.
+func (r *storeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a store of a merchant account. Without merchant_id the store is created for the merchant account of the provider, " +
+			"with merchant_id it is created through the company-level endpoint for any merchant account the API credential can reach.\n\n" +
+			"Adyen does not allow deleting stores, so destroying this resource sets the status of the store to inactive instead.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Stores read and write",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the store.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"merchant_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the merchant account the store belongs to. Defaults to the merchant account of the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"reference": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "A reference to recognize the store by. Also known as the store code. If not set, Adyen generates one.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Required:    true,
+				Description: "Your description of the store.",
+			},
+			"shopper_statement": schema.StringAttribute{
+				Required:    true,
+				Description: "The store name to be shown on the shopper's bank or credit card statement and on the shopper receipt. Maximum length: 22 characters.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"phone_number": schema.StringAttribute{
+				Required:    true,
+				Description: "The phone number of the store, including '+' and country code.",
+			},
+			"external_reference_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Used by certain payment methods and tax authorities to uniquely identify the store.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The address of the store.",
+				Attributes: map[string]schema.Attribute{
+					"country": schema.StringAttribute{
+						Required:    true,
+						Description: "The two-letter country code in ISO 3166-1 alpha-2 format. Changing it forces a new store to be created.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"line1": schema.StringAttribute{
+						Optional:    true,
+						Description: "The street address, including the house number.",
+					},
+					"line2": schema.StringAttribute{
+						Optional:    true,
+						Description: "Second address line.",
+					},
+					"line3": schema.StringAttribute{
+						Optional:    true,
+						Description: "Third address line.",
+					},
+					"city": schema.StringAttribute{
+						Optional:    true,
+						Description: "The name of the city.",
+					},
+					"postal_code": schema.StringAttribute{
+						Optional:    true,
+						Description: "The postal code.",
+					},
+					"state_or_province": schema.StringAttribute{
+						Optional:    true,
+						Description: "The state or province code as defined in ISO 3166-2. Required for stores in Australia, Brazil, Canada and the US.",
+					},
+				},
+			},
+			"business_line_ids": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The unique identifiers of the business lines that the store is associated with. Required for an Adyen for Platforms Manage integration.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"split_configuration": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Rules for Adyen for Platforms merchants to split the transaction amount and fees.",
+				Attributes: map[string]schema.Attribute{
+					"balance_account_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "The unique identifier of the balance account to which the split amount must be booked.",
+					},
+					"split_configuration_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "The unique identifier of the split configuration profile.",
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The status of the store: active, inactive or closed. New stores are active. A closed store cannot be reopened.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(storeStatuses...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *storeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen store")
+
+	// Retrieve values from the plan
+	var plan storeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	businessLineIDs, diags := mapSetToStrings(ctx, plan.BusinessLineIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	address := management.StoreLocation{
+		Country:         plan.Address.Country.ValueString(),
+		Line1:           plan.Address.Line1.ValueStringPointer(),
+		Line2:           plan.Address.Line2.ValueStringPointer(),
+		Line3:           plan.Address.Line3.ValueStringPointer(),
+		City:            plan.Address.City.ValueStringPointer(),
+		PostalCode:      plan.Address.PostalCode.ValueStringPointer(),
+		StateOrProvince: plan.Address.StateOrProvince.ValueStringPointer(),
+	}
+
+	var store management.Store
+	var err error
+	if merchantID := knownStringPointer(plan.MerchantID); merchantID != nil {
+		createStoreInput := r.client.Management().AccountStoreLevelApi.CreateStoreInput().StoreCreationWithMerchantCodeRequest(management.StoreCreationWithMerchantCodeRequest{
+			MerchantId:          *merchantID,
+			Address:             address,
+			BusinessLineIds:     businessLineIDs,
+			Description:         plan.Description.ValueString(),
+			ExternalReferenceId: knownStringPointer(plan.ExternalReferenceID),
+			PhoneNumber:         plan.PhoneNumber.ValueString(),
+			Reference:           knownStringPointer(plan.Reference),
+			ShopperStatement:    plan.ShopperStatement.ValueString(),
+			SplitConfiguration:  mapStoreSplitConfigurationRequest(plan.SplitConfiguration),
+		})
+		store, _, err = r.client.Management().AccountStoreLevelApi.CreateStore(ctx, createStoreInput)
+	} else {
+		createStoreByMerchantIdInput := r.client.Management().AccountStoreLevelApi.CreateStoreByMerchantIdInput(r.client.GetConfig().MerchantAccount).StoreCreationRequest(management.StoreCreationRequest{
+			Address:             address,
+			BusinessLineIds:     businessLineIDs,
+			Description:         plan.Description.ValueString(),
+			ExternalReferenceId: knownStringPointer(plan.ExternalReferenceID),
+			PhoneNumber:         plan.PhoneNumber.ValueString(),
+			Reference:           knownStringPointer(plan.Reference),
+			ShopperStatement:    plan.ShopperStatement.ValueString(),
+			SplitConfiguration:  mapStoreSplitConfigurationRequest(plan.SplitConfiguration),
+		})
+		store, _, err = r.client.Management().AccountStoreLevelApi.CreateStoreByMerchantId(ctx, createStoreByMerchantIdInput)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating store",
+			"Could not create store, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save the created store right away, so it is not left behind outside of the state when setting the status fails.
+	diags = resp.State.Set(ctx, mapStoreResourceModel(store))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Stores are always created as active, so set the requested status right away.
+	// If that fails the store is kept in the state with an error, so Terraform taints it and replaces it on the next apply.
+	if status := knownStringPointer(plan.Status); status != nil && *status != store.GetStatus() {
+		storeID := store.GetId()
+		updateStoreByIdInput := r.client.Management().AccountStoreLevelApi.UpdateStoreByIdInput(storeID).UpdateStoreRequest(management.UpdateStoreRequest{Status: status})
+		store, _, err = r.client.Management().AccountStoreLevelApi.UpdateStoreById(ctx, updateStoreByIdInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating store",
+				"Could not set the status of store "+storeID+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan = mapStoreResourceModel(store)
+
+	// Set state with the fully populated store
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *storeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state storeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getStoreByIdInput := r.client.Management().AccountStoreLevelApi.GetStoreByIdInput(state.ID.ValueString())
+	store, httpRes, err := r.client.Management().AccountStoreLevelApi.GetStoreById(ctx, getStoreByIdInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the store does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Store",
+			"Could not read store "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = mapStoreResourceModel(store)
+
+	tflog.Debug(ctx, "Reading store...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *storeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating adyen store")
+
+	// Retrieve values from the plan and the current state
+	var plan, state storeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	businessLineIDs, diags := mapSetToStrings(ctx, plan.BusinessLineIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan. The country of a store cannot be changed, so it is not part of the request.
+	// Adyen only updates the fields in the request, so address lines that are removed from the configuration are sent empty to clear them.
+	updateStoreRequest := management.UpdateStoreRequest{
+		Address: &management.UpdatableAddress{
+			Line1:           clearableStringPointer(plan.Address.Line1),
+			Line2:           clearableStringPointer(plan.Address.Line2),
+			Line3:           clearableStringPointer(plan.Address.Line3),
+			City:            clearableStringPointer(plan.Address.City),
+			PostalCode:      clearableStringPointer(plan.Address.PostalCode),
+			StateOrProvince: clearableStringPointer(plan.Address.StateOrProvince),
+		},
+		BusinessLineIds:     businessLineIDs,
+		Description:         plan.Description.ValueStringPointer(),
+		ExternalReferenceId: knownStringPointer(plan.ExternalReferenceID),
+		PhoneNumber:         plan.PhoneNumber.ValueStringPointer(),
+		SplitConfiguration:  mapStoreSplitConfigurationUpdateRequest(plan.SplitConfiguration, state.SplitConfiguration),
+		Status:              knownStringPointer(plan.Status),
+	}
+
+	updateStoreByIdInput := r.client.Management().AccountStoreLevelApi.UpdateStoreByIdInput(plan.ID.ValueString()).UpdateStoreRequest(updateStoreRequest)
+	store, _, err := r.client.Management().AccountStoreLevelApi.UpdateStoreById(ctx, updateStoreByIdInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating store",
+			"Could not update store "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan = mapStoreResourceModel(store)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete sets the store to inactive and removes the Terraform state on success, as Adyen does not allow deleting stores.
+func (r *storeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state storeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A closed store cannot be reopened, so there is nothing left to deactivate.
+	if state.Status.ValueString() == "closed" {
+		return
+	}
+
+	updateStoreByIdInput := r.client.Management().AccountStoreLevelApi.UpdateStoreByIdInput(state.ID.ValueString()).UpdateStoreRequest(management.UpdateStoreRequest{Status: common.PtrString("inactive")})
+	_, httpRes, err := r.client.Management().AccountStoreLevelApi.UpdateStoreById(ctx, updateStoreByIdInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // The store no longer exists, so there is nothing to deactivate.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Store",
+			"Could not deactivate store "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing store using its identifier.
+func (r *storeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
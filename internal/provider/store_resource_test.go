@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func testAccCheckAdyenStoreDestroy(tfstate *terraform.State) error {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	for _, rs := range tfstate.RootModule().Resources {
+		if rs.Type != "adyen_store" {
+			continue
+		}
+
+		// Adyen does not allow deleting stores, so destroying the resource deactivates the store.
+		data := client.Management().AccountStoreLevelApi.GetStoreByIdInput(rs.Primary.ID)
+		store, resp, err := client.Management().AccountStoreLevelApi.GetStoreById(context.Background(), data)
+		if resp != nil && resp.StatusCode == 422 { // 422 Unprocessable Entity error code from Adyen if resource does not exist.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if store.GetStatus() == "active" {
+			return fmt.Errorf("adyen_store with id: '%s' is still active", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccStoreResource(t *testing.T) {
+	merchantStore := "adyen_store.merchant"
+	companyStore := "adyen_store.company"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenStoreDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigStore("+31201234567", "Simon Carmiggeltstraat 6-50", "active", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(merchantStore, "id"),
+					resource.TestCheckResourceAttr(merchantStore, "merchant_id", "WeaveAccountECOM"),
+					resource.TestCheckResourceAttr(merchantStore, "reference", "TerraformStore"),
+					resource.TestCheckResourceAttr(merchantStore, "shopper_statement", "Terraform Store"),
+					resource.TestCheckResourceAttr(merchantStore, "phone_number", "+31201234567"),
+					resource.TestCheckResourceAttr(merchantStore, "address.country", "NL"),
+					resource.TestCheckResourceAttr(merchantStore, "address.line1", "Simon Carmiggeltstraat 6-50"),
+					resource.TestCheckResourceAttr(merchantStore, "address.line2", "Floor 5"),
+					resource.TestCheckResourceAttr(merchantStore, "split_configuration.balance_account_id", "BA3227C223222B5FG88S28BGN"),
+					resource.TestCheckResourceAttr(merchantStore, "split_configuration.split_configuration_id", "SCNF4224P22322345SCB8QDVJ"),
+					resource.TestCheckResourceAttr(merchantStore, "status", "active"),
+					resource.TestCheckResourceAttrSet(companyStore, "id"),
+					resource.TestCheckResourceAttr(companyStore, "merchant_id", "WeaveAccountPOS"),
+					resource.TestCheckResourceAttrSet(companyStore, "reference"),
+					resource.TestCheckResourceAttr(companyStore, "status", "inactive"),
+				),
+			},
+			{
+				ResourceName:      merchantStore,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing line2 and the split configuration from the configuration clears them.
+				Config: testProviderClientFromTmpl(t) + testConfigStore("+31207654321", "Rokin 49", "inactive", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(merchantStore, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(merchantStore, "phone_number", "+31207654321"),
+					resource.TestCheckResourceAttr(merchantStore, "address.line1", "Rokin 49"),
+					resource.TestCheckResourceAttr(merchantStore, "address.country", "NL"),
+					resource.TestCheckResourceAttr(merchantStore, "status", "inactive"),
+					resource.TestCheckNoResourceAttr(merchantStore, "address.line2"),
+					resource.TestCheckNoResourceAttr(merchantStore, "split_configuration"),
+				),
+			},
+		},
+	})
+}
+
+// testConfigStore configures a merchant store and a company store, adding line2 and a split configuration to the merchant store if withOptional is set.
+func testConfigStore(phoneNumber string, line1 string, status string, withOptional bool) string {
+	line2, splitConfiguration := "", ""
+	if withOptional {
+		line2 = `line2       = "Floor 5"`
+		splitConfiguration = `split_configuration = {
+			balance_account_id     = "BA3227C223222B5FG88S28BGN"
+			split_configuration_id = "SCNF4224P22322345SCB8QDVJ"
+		}`
+	}
+	return fmt.Sprintf(`
+	resource "adyen_store" "merchant" {
+		reference         = "TerraformStore"
+		description       = "Terraform store"
+		shopper_statement = "Terraform Store"
+		phone_number      = "%s"
+		status            = "%s"
+		address = {
+			country     = "NL"
+			line1       = "%s"
+			%s
+			city        = "Amsterdam"
+			postal_code = "1011 DJ"
+		}
+		%s
+	}
+
+	resource "adyen_store" "company" {
+		merchant_id       = "WeaveAccountPOS"
+		description       = "Terraform company store"
+		shopper_statement = "Terraform POS"
+		phone_number      = "+31201234567"
+		status            = "inactive"
+		address = {
+			country = "NL"
+			city    = "Amsterdam"
+		}
+	}
+`, phoneNumber, status, line1, line2, splitConfiguration)
+}
@@ -0,0 +1,632 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &terminalActionResource{}
+	_ resource.ResourceWithConfigure      = &terminalActionResource{}
+	_ resource.ResourceWithValidateConfig = &terminalActionResource{}
+	_ resource.ResourceWithModifyPlan     = &terminalActionResource{}
+)
+
+// Allowed values of the type of a terminal action.
+var terminalActionTypes = []string{
+	"InstallAndroidApp",
+	"UninstallAndroidApp",
+	"InstallAndroidCertificate",
+	"UninstallAndroidCertificate",
+	"ReleaseUpdate",
+}
+
+// terminalActionRejectedKey is the private state key that records the terminals Adyen rejected the action for,
+// for example because they do not exist, so the action is not scheduled on them again with every apply.
+const terminalActionRejectedKey = "rejected_terminal_ids"
+
+// terminalActionResource is the resource implementation.
+type terminalActionResource struct {
+	client *adyen.APIClient
+}
+
+// NewTerminalActionResource is a helper function to simplify the provider implementation.
+func NewTerminalActionResource() resource.Resource {
+	return &terminalActionResource{}
+}
+
+// terminalActionResourceModel maps the "terminal_action" schema data for a resource.
+type terminalActionResourceModel struct {
+	ID                           types.String `tfsdk:"id"`
+	CompanyID                    types.String `tfsdk:"company_id"`
+	StoreID                      types.String `tfsdk:"store_id"`
+	Type                         types.String `tfsdk:"type"`
+	AppID                        types.String `tfsdk:"app_id"`
+	CertificateID                types.String `tfsdk:"certificate_id"`
+	UpdateAtFirstMaintenanceCall types.Bool   `tfsdk:"update_at_first_maintenance_call"`
+	TerminalIDs                  types.Set    `tfsdk:"terminal_ids"`
+	ScheduledAt                  types.String `tfsdk:"scheduled_at"`
+	Actions                      types.List   `tfsdk:"actions"`
+}
+
+// terminalActionStatusModel maps the status of a scheduled action on one terminal.
+type terminalActionStatusModel struct {
+	ID          types.String `tfsdk:"id"`
+	TerminalID  types.String `tfsdk:"terminal_id"`
+	Status      types.String `tfsdk:"status"`
+	Result      types.String `tfsdk:"result"`
+	ConfirmedAt types.String `tfsdk:"confirmed_at"`
+}
+
+// terminalActionStatusAttributeTypes are the attribute types of the status of a scheduled action on one terminal.
+var terminalActionStatusAttributeTypes = map[string]attr.Type{
+	"id":           types.StringType,
+	"terminal_id":  types.StringType,
+	"status":       types.StringType,
+	"result":       types.StringType,
+	"confirmed_at": types.StringType,
+}
+
+// mapTerminalActionDetailsRequest maps the configured action to the details of an Adyen API request.
+func mapTerminalActionDetailsRequest(plan terminalActionResourceModel) *management.ScheduleTerminalActionsRequestActionDetails {
+	actionType := plan.Type.ValueStringPointer()
+	switch plan.Type.ValueString() {
+	case "InstallAndroidApp":
+		return &management.ScheduleTerminalActionsRequestActionDetails{
+			InstallAndroidAppDetails: &management.InstallAndroidAppDetails{Type: actionType, AppId: plan.AppID.ValueStringPointer()},
+		}
+	case "UninstallAndroidApp":
+		return &management.ScheduleTerminalActionsRequestActionDetails{
+			UninstallAndroidAppDetails: &management.UninstallAndroidAppDetails{Type: actionType, AppId: plan.AppID.ValueStringPointer()},
+		}
+	case "InstallAndroidCertificate":
+		return &management.ScheduleTerminalActionsRequestActionDetails{
+			InstallAndroidCertificateDetails: &management.InstallAndroidCertificateDetails{Type: actionType, CertificateId: plan.CertificateID.ValueStringPointer()},
+		}
+	case "UninstallAndroidCertificate":
+		return &management.ScheduleTerminalActionsRequestActionDetails{
+			UninstallAndroidCertificateDetails: &management.UninstallAndroidCertificateDetails{Type: actionType, CertificateId: plan.CertificateID.ValueStringPointer()},
+		}
+	default:
+		return &management.ScheduleTerminalActionsRequestActionDetails{
+			ReleaseUpdateDetails: &management.ReleaseUpdateDetails{Type: actionType, UpdateAtFirstMaintenanceCall: plan.UpdateAtFirstMaintenanceCall.ValueBoolPointer()},
+		}
+	}
+}
+
+// mapTerminalActionStatus maps a terminal action returned by the Adyen API to the status of the action on its terminal.
+func mapTerminalActionStatus(action management.ExternalTerminalAction) terminalActionStatusModel {
+	status := terminalActionStatusModel{
+		ID:          types.StringPointerValue(action.Id),
+		TerminalID:  types.StringPointerValue(action.TerminalId),
+		Status:      types.StringPointerValue(action.Status),
+		Result:      types.StringPointerValue(action.Result),
+		ConfirmedAt: types.StringNull(),
+	}
+	if action.ConfirmedAt != nil {
+		status.ConfirmedAt = types.StringValue(action.ConfirmedAt.Format(time.RFC3339))
+	}
+	return status
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *terminalActionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *terminalActionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terminal_action"
+}
+
+// Schema defines the schema for the resource.
+func (r *terminalActionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Schedules an action on a list of payment terminals: installing or uninstalling an Android app or certificate, or updating the terminal software release. " +
+			"The action is sent to each terminal with its first maintenance call after scheduled_at, and the status per terminal is refreshed on every read. " +
+			"If Adyen rejects some of the terminals, for example because they do not exist or are not assigned to the store, the action is kept for the other terminals and the rejected terminals are not retried; " +
+			"to schedule the action on them once the problem is resolved, replace the resource with terraform apply -replace. " +
+			"Changing any attribute schedules a new action. Scheduled actions cannot be cancelled through the API, so destroying this resource only removes it from the Terraform state.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Terminal actions read and write",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier of the action scheduled on the first terminal.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"company_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the company account the terminals belong to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"store_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The unique identifier of the store the terminals are assigned to. If set, all terminals must be assigned to this store.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of action: InstallAndroidApp, UninstallAndroidApp, InstallAndroidCertificate, UninstallAndroidCertificate or ReleaseUpdate.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(terminalActionTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The unique identifier of the Android app to install or uninstall. Required for the InstallAndroidApp and UninstallAndroidApp actions.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The unique identifier of the Android certificate to install or uninstall. Required for the InstallAndroidCertificate and UninstallAndroidCertificate actions.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"update_at_first_maintenance_call": schema.BoolAttribute{
+				Optional:    true,
+				Description: "For the ReleaseUpdate action, indicates whether terminals update at their next maintenance call instead of at their configured restart time.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"terminal_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The unique identifiers of the terminals to apply the action to, e.g. V400m-080020970. At most 100 terminals.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"scheduled_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "The date and time after which the action is sent to the terminals, in RFC 3339 format without the Z before the time offset, e.g. 2024-11-15T12:16:21+01:00. If not set, the action is sent with the next maintenance call.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"actions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The status of the scheduled action on each terminal, ordered by terminal.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the action on the terminal.",
+						},
+						"terminal_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the terminal.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the action on the terminal: pending, successful, failed, cancelled or tryLater.",
+						},
+						"result": schema.StringAttribute{
+							Computed:    true,
+							Description: "The result message of the action on the terminal.",
+						},
+						"confirmed_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time the action was carried out on the terminal.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the app or certificate is set for the actions that need one.
+func (r *terminalActionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config terminalActionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+
+	actionType := config.Type.ValueString()
+	isAppAction := strings.HasSuffix(actionType, "AndroidApp")
+	isCertificateAction := strings.HasSuffix(actionType, "AndroidCertificate")
+
+	if isAppAction && config.AppID.IsNull() {
+		addTerminalActionAttributeError(resp, "app_id", "Missing Attribute Configuration", "must be set", actionType)
+	}
+	if !isAppAction && !config.AppID.IsNull() {
+		addTerminalActionAttributeError(resp, "app_id", "Invalid Attribute Combination", "cannot be set", actionType)
+	}
+	if isCertificateAction && config.CertificateID.IsNull() {
+		addTerminalActionAttributeError(resp, "certificate_id", "Missing Attribute Configuration", "must be set", actionType)
+	}
+	if !isCertificateAction && !config.CertificateID.IsNull() {
+		addTerminalActionAttributeError(resp, "certificate_id", "Invalid Attribute Combination", "cannot be set", actionType)
+	}
+	if actionType != "ReleaseUpdate" && !config.UpdateAtFirstMaintenanceCall.IsNull() {
+		addTerminalActionAttributeError(resp, "update_at_first_maintenance_call", "Invalid Attribute Combination", "cannot be set", actionType)
+	}
+}
+
+// addTerminalActionAttributeError reports an attribute that must or cannot be set for the configured action type.
+func addTerminalActionAttributeError(resp *resource.ValidateConfigResponse, attribute string, summary string, problem string, actionType string) {
+	resp.Diagnostics.AddAttributeError(
+		path.Root(attribute),
+		summary,
+		fmt.Sprintf("%s %s for the %s action.", attribute, problem, actionType),
+	)
+}
+
+// Create schedules the terminal action and sets the initial Terraform state.
+func (r *terminalActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen terminal action")
+
+	// Retrieve values from the plan
+	var plan terminalActionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	terminalIDs, diags := mapSetToStrings(ctx, plan.TerminalIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statuses, terminalsWithErrors, err := r.scheduleAction(ctx, plan, terminalIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating terminal action",
+			"Could not schedule terminal action, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if len(statuses) == 0 {
+		resp.Diagnostics.AddError(
+			"Error creating terminal action",
+			"Could not schedule terminal action on any terminal: "+formatTerminalsWithErrors(terminalsWithErrors),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(r.refreshActions(ctx, &plan, statuses)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An error would taint the resource and schedule the action again on the terminals it is already scheduled on,
+	// so the rejected terminals are only reported, and recorded so they are not retried, see ModifyPlan.
+	if len(statuses) < len(terminalIDs) {
+		addTerminalsWithErrorsWarning(&resp.Diagnostics, terminalsWithErrors)
+		resp.Diagnostics.Append(setRejectedTerminalIDs(ctx, resp.Private, terminalIDsWithErrors(terminalsWithErrors))...)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *terminalActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state terminalActionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statuses []terminalActionStatusModel
+	diags = state.Actions.ElementsAs(ctx, &statuses, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refreshActions(ctx, &state, statuses)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading terminal action...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan plans an update when the action is not scheduled on all terminals yet, to schedule it on the remaining terminals.
+// Terminals Adyen rejected the action for are not retried.
+func (r *terminalActionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state terminalActionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !plan.TerminalIDs.Equal(state.TerminalIDs) {
+		return
+	}
+
+	rejected, diags := getRejectedTerminalIDs(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	unscheduled, diags := unscheduledTerminalIDs(ctx, state, rejected)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(unscheduled) == 0 {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("actions"), types.ListUnknown(types.ObjectType{AttrTypes: terminalActionStatusAttributeTypes}))...)
+}
+
+// Update schedules the action on the terminals it is not scheduled on yet, and records the terminals Adyen rejects.
+// All attributes that can be configured require a new action.
+func (r *terminalActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state terminalActionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statuses []terminalActionStatusModel
+	diags = state.Actions.ElementsAs(ctx, &statuses, false)
+	resp.Diagnostics.Append(diags...)
+	rejected, diags := getRejectedTerminalIDs(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	unscheduled, diags := unscheduledTerminalIDs(ctx, state, rejected)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(unscheduled) > 0 {
+		scheduled, terminalsWithErrors, err := r.scheduleAction(ctx, plan, unscheduled)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating terminal action",
+				"Could not schedule terminal action, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		statuses = append(statuses, scheduled...)
+		if len(scheduled) < len(unscheduled) {
+			addTerminalsWithErrorsWarning(&resp.Diagnostics, terminalsWithErrors)
+			resp.Diagnostics.Append(setRejectedTerminalIDs(ctx, resp.Private, append(rejected, terminalIDsWithErrors(terminalsWithErrors)...))...)
+		}
+	}
+
+	resp.Diagnostics.Append(r.refreshActions(ctx, &plan, statuses)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the terminal action from the Terraform state. Scheduled actions cannot be cancelled through the API.
+func (r *terminalActionResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing terminal action from state")
+}
+
+// scheduleAction schedules the configured action on the given terminals, and returns the pending status of the action on each terminal it was scheduled on
+// together with the errors of the terminals it could not be scheduled on.
+func (r *terminalActionResource) scheduleAction(ctx context.Context, plan terminalActionResourceModel, terminalIDs []string) ([]terminalActionStatusModel, *map[string][]string, error) {
+	// Generate API request body from plan
+	scheduleTerminalActionsRequest := management.ScheduleTerminalActionsRequest{
+		ActionDetails: mapTerminalActionDetailsRequest(plan),
+		ScheduledAt:   plan.ScheduledAt.ValueStringPointer(),
+		StoreId:       plan.StoreID.ValueStringPointer(),
+		TerminalIds:   terminalIDs,
+	}
+	createTerminalActionInput := r.client.Management().TerminalActionsTerminalLevelApi.CreateTerminalActionInput().ScheduleTerminalActionsRequest(scheduleTerminalActionsRequest)
+	scheduled, _, err := r.client.Management().TerminalActionsTerminalLevelApi.CreateTerminalAction(ctx, createTerminalActionInput)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := []terminalActionStatusModel{}
+	for _, item := range scheduled.Items {
+		statuses = append(statuses, terminalActionStatusModel{
+			ID:          types.StringPointerValue(item.Id),
+			TerminalID:  types.StringPointerValue(item.TerminalId),
+			Status:      types.StringValue("pending"),
+			Result:      types.StringNull(),
+			ConfirmedAt: types.StringNull(),
+		})
+	}
+	return statuses, scheduled.TerminalsWithErrors, nil
+}
+
+// unscheduledTerminalIDs returns the configured terminals the action is not scheduled on and that were not rejected, sorted by terminal.
+func unscheduledTerminalIDs(ctx context.Context, model terminalActionResourceModel, rejected []string) ([]string, diag.Diagnostics) {
+	terminalIDs, diags := mapSetToStrings(ctx, model.TerminalIDs)
+	var statuses []terminalActionStatusModel
+	diags.Append(model.Actions.ElementsAs(ctx, &statuses, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	scheduled := make(map[string]bool)
+	for _, status := range statuses {
+		scheduled[status.TerminalID.ValueString()] = true
+	}
+	for _, terminalID := range rejected {
+		scheduled[terminalID] = true
+	}
+	var unscheduled []string
+	for _, terminalID := range terminalIDs {
+		if !scheduled[terminalID] {
+			unscheduled = append(unscheduled, terminalID)
+		}
+	}
+	sort.Strings(unscheduled)
+	return unscheduled, diags
+}
+
+// addTerminalsWithErrorsWarning reports the terminals an action could not be scheduled on.
+func addTerminalsWithErrorsWarning(diags *diag.Diagnostics, terminalsWithErrors *map[string][]string) {
+	diags.AddWarning(
+		"Terminal action not scheduled on all terminals",
+		"Could not schedule terminal action on all terminals: "+formatTerminalsWithErrors(terminalsWithErrors)+". "+
+			"The action is not scheduled on these terminals again; once the problem is resolved, run terraform apply with -replace to schedule the action again.",
+	)
+}
+
+// terminalIDsWithErrors returns the terminals Adyen rejected an action for.
+func terminalIDsWithErrors(terminalsWithErrors *map[string][]string) []string {
+	if terminalsWithErrors == nil {
+		return nil
+	}
+	var terminalIDs []string
+	for _, ids := range *terminalsWithErrors {
+		terminalIDs = append(terminalIDs, ids...)
+	}
+	return terminalIDs
+}
+
+// getRejectedTerminalIDs returns the terminals recorded under terminalActionRejectedKey.
+func getRejectedTerminalIDs(ctx context.Context, private interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+}) ([]string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, terminalActionRejectedKey)
+	if diags.HasError() || value == nil {
+		return nil, diags
+	}
+	var terminalIDs []string
+	if err := json.Unmarshal(value, &terminalIDs); err != nil {
+		diags.AddError("Error reading rejected terminals", "Could not read the terminals the action was rejected for: "+err.Error())
+	}
+	return terminalIDs, diags
+}
+
+// setRejectedTerminalIDs records the terminals Adyen rejected the action for under terminalActionRejectedKey.
+func setRejectedTerminalIDs(ctx context.Context, private interface {
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}, terminalIDs []string) diag.Diagnostics {
+	sort.Strings(terminalIDs)
+	value, err := json.Marshal(terminalIDs)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error recording rejected terminals", "Could not record the terminals the action was rejected for: "+err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, terminalActionRejectedKey, value)
+}
+
+// refreshActions looks up the current status of each scheduled action and sets the statuses, ordered by terminal, in the model.
+// Adyen responds with 422 Unprocessable Entity once an action is no longer available, in which case its last known status is kept,
+// so that the action is not scheduled again.
+func (r *terminalActionResource) refreshActions(ctx context.Context, model *terminalActionResourceModel, statuses []terminalActionStatusModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	refreshed := []attr.Value{}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].TerminalID.ValueString() < statuses[j].TerminalID.ValueString()
+	})
+	for _, status := range statuses {
+		getTerminalActionInput := r.client.Management().TerminalActionsCompanyLevelApi.GetTerminalActionInput(model.CompanyID.ValueString(), status.ID.ValueString())
+		action, httpRes, err := r.client.Management().TerminalActionsCompanyLevelApi.GetTerminalAction(ctx, getTerminalActionInput)
+		if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity {
+			value, objectDiags := types.ObjectValueFrom(ctx, terminalActionStatusAttributeTypes, status)
+			diags.Append(objectDiags...)
+			refreshed = append(refreshed, value)
+			continue
+		}
+		if err != nil {
+			diags.AddError(
+				"Error Reading Terminal Action",
+				"Could not read terminal action "+status.ID.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+
+		value, objectDiags := types.ObjectValueFrom(ctx, terminalActionStatusAttributeTypes, mapTerminalActionStatus(action))
+		diags.Append(objectDiags...)
+		refreshed = append(refreshed, value)
+	}
+
+	actions, listDiags := types.ListValue(types.ObjectType{AttrTypes: terminalActionStatusAttributeTypes}, refreshed)
+	diags.Append(listDiags...)
+	model.Actions = actions
+	if len(statuses) > 0 && model.ID.IsUnknown() {
+		model.ID = statuses[0].ID
+	}
+	return diags
+}
+
+// formatTerminalsWithErrors describes the terminals an action could not be scheduled on, by error.
+func formatTerminalsWithErrors(terminalsWithErrors *map[string][]string) string {
+	if terminalsWithErrors == nil {
+		return "no errors returned"
+	}
+	var descriptions []string
+	for message, terminalIDs := range *terminalsWithErrors {
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s)", message, strings.Join(terminalIDs, ", ")))
+	}
+	sort.Strings(descriptions)
+	return strings.Join(descriptions, "; ")
+}
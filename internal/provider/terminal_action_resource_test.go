@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"regexp"
+	"testing"
+)
+
+func TestAccTerminalActionResource(t *testing.T) {
+	installApp := "adyen_terminal_action.install_app"
+	releaseUpdate := "adyen_terminal_action.release_update"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + `
+				resource "adyen_terminal_action" "invalid" {
+					company_id   = "WeaveAccount"
+					type         = "InstallAndroidApp"
+					terminal_ids = ["V400m-080020970"]
+				}
+				`,
+				ExpectError: regexp.MustCompile(`app_id must be set for the InstallAndroidApp action`),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalAction(`"V400m-080020971", "V400m-080020970"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(installApp, "id"),
+					resource.TestCheckResourceAttr(installApp, "terminal_ids.#", "2"),
+					resource.TestCheckResourceAttr(installApp, "scheduled_at", "2024-11-15T12:16:21+01:00"),
+					resource.TestCheckResourceAttr(installApp, "actions.#", "2"),
+					resource.TestCheckResourceAttr(installApp, "actions.0.terminal_id", "V400m-080020970"),
+					resource.TestCheckResourceAttr(installApp, "actions.0.status", "pending"),
+					resource.TestCheckResourceAttrPair(installApp, "id", installApp, "actions.0.id"),
+					resource.TestCheckResourceAttr(installApp, "actions.1.terminal_id", "V400m-080020971"),
+					resource.TestCheckResourceAttr(releaseUpdate, "actions.#", "1"),
+					resource.TestCheckResourceAttr(releaseUpdate, "actions.0.status", "pending"),
+				),
+			},
+			{
+				// The status of each terminal is refreshed once the terminal carries out the action.
+				SkipFunc: func() (bool, error) {
+					return testMockServer == nil, nil
+				},
+				PreConfig: func() {
+					testMockServer.completeTerminalActions("V400m-080020970")
+				},
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalAction(`"V400m-080020971", "V400m-080020970"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(installApp, "actions.0.status", "successful"),
+					resource.TestCheckResourceAttrSet(installApp, "actions.0.confirmed_at"),
+					resource.TestCheckResourceAttr(installApp, "actions.1.status", "pending"),
+				),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalAction(`"V400m-080020970"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(installApp, plancheck.ResourceActionDestroyBeforeCreate),
+						plancheck.ExpectResourceAction(releaseUpdate, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(installApp, "actions.#", "1"),
+			},
+			{
+				// The action is kept for the terminals it is scheduled on. Adyen rejected the other terminal, so no update is planned to retry it.
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalAction(`"V400m-080020970", "E355-401999999"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(installApp, "terminal_ids.#", "2"),
+					resource.TestCheckResourceAttr(installApp, "actions.#", "1"),
+					resource.TestCheckResourceAttr(installApp, "actions.0.terminal_id", "V400m-080020970"),
+				),
+			},
+			{
+				// A rejected terminal is only scheduled again when the resource is replaced, even once it is available. The status of an action
+				// Adyen no longer returns is kept, so that the action is not scheduled again.
+				SkipFunc: func() (bool, error) {
+					return testMockServer == nil, nil
+				},
+				PreConfig: func() {
+					testMockServer.addTerminal("E355-401999999", "WeaveAccount")
+					testMockServer.expireTerminalActions("V400m-080020970")
+				},
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalAction(`"V400m-080020970", "E355-401999999"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(installApp, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(installApp, "actions.#", "1"),
+					resource.TestCheckResourceAttr(installApp, "actions.0.terminal_id", "V400m-080020970"),
+					resource.TestCheckResourceAttr(installApp, "actions.0.status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+func testConfigTerminalAction(terminalIDs string) string {
+	return fmt.Sprintf(`
+	resource "adyen_terminal_action" "install_app" {
+		company_id   = "WeaveAccount"
+		type         = "InstallAndroidApp"
+		app_id       = "ANDA422LZ223223K5F694GCCF732K8"
+		terminal_ids = [%s]
+		scheduled_at = "2024-11-15T12:16:21+01:00"
+	}
+
+	resource "adyen_terminal_action" "release_update" {
+		company_id                       = "WeaveAccount"
+		type                             = "ReleaseUpdate"
+		update_at_first_maintenance_call = true
+		terminal_ids                     = ["S1F2-000158212345678"]
+	}
+`, terminalIDs)
+}
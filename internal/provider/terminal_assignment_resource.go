@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &terminalAssignmentResource{}
+	_ resource.ResourceWithConfigure        = &terminalAssignmentResource{}
+	_ resource.ResourceWithConfigValidators = &terminalAssignmentResource{}
+	_ resource.ResourceWithImportState      = &terminalAssignmentResource{}
+)
+
+// terminalAssignmentResource is the resource implementation.
+type terminalAssignmentResource struct {
+	client *adyen.APIClient
+}
+
+// NewTerminalAssignmentResource is a helper function to simplify the provider implementation.
+func NewTerminalAssignmentResource() resource.Resource {
+	return &terminalAssignmentResource{}
+}
+
+// terminalAssignmentResourceModel maps the "terminal_assignment" schema data for a resource.
+type terminalAssignmentResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	TerminalID types.String `tfsdk:"terminal_id"`
+	CompanyID  types.String `tfsdk:"company_id"`
+	MerchantID types.String `tfsdk:"merchant_id"`
+	StoreID    types.String `tfsdk:"store_id"`
+	Status     types.String `tfsdk:"status"`
+}
+
+// mapTerminalReassignmentRequest maps the configured target of the terminal to an Adyen API request.
+func mapTerminalReassignmentRequest(plan terminalAssignmentResourceModel) management.TerminalReassignmentRequest {
+	switch {
+	case !plan.StoreID.IsNull():
+		return management.TerminalReassignmentRequest{StoreId: plan.StoreID.ValueStringPointer()}
+	case !plan.MerchantID.IsNull():
+		return management.TerminalReassignmentRequest{MerchantId: plan.MerchantID.ValueStringPointer(), Inventory: common.PtrBool(true)}
+	default:
+		return management.TerminalReassignmentRequest{CompanyId: plan.CompanyID.ValueStringPointer()}
+	}
+}
+
+// mapTerminalAssignmentResourceModel maps the assignment of a terminal returned by the Adyen API to the Terraform model.
+// While a reassignment is in progress, the target of the reassignment is used, so an apply is not followed by a diff.
+func mapTerminalAssignmentResourceModel(assignment management.TerminalAssignment, model terminalAssignmentResourceModel) terminalAssignmentResourceModel {
+	companyID, merchantID, storeID := common.PtrString(assignment.CompanyId), assignment.MerchantId, assignment.StoreId
+	if target := assignment.ReassignmentTarget; target != nil {
+		companyID, merchantID, storeID = target.CompanyId, target.MerchantId, target.StoreId
+	}
+
+	// Only the most specific level is kept, as that is the one the terminal is reassigned to.
+	model.CompanyID = types.StringNull()
+	model.MerchantID = types.StringNull()
+	model.StoreID = types.StringNull()
+	switch {
+	case storeID != nil:
+		model.StoreID = types.StringPointerValue(storeID)
+	case merchantID != nil:
+		model.MerchantID = types.StringPointerValue(merchantID)
+	default:
+		model.CompanyID = types.StringPointerValue(companyID)
+	}
+	model.Status = types.StringValue(assignment.Status)
+	return model
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *terminalAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *terminalAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terminal_assignment"
+}
+
+// ConfigValidators returns the validations that span multiple attributes of the terminal assignment.
+func (r *terminalAssignmentResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("company_id"),
+			path.MatchRoot("merchant_id"),
+			path.MatchRoot("store_id"),
+		),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *terminalAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reassigns a payment terminal to the inventory of a company account, the inventory of a merchant account, or a store. " +
+			"A terminal that is boarded keeps its current assignment until its next maintenance call, and is shown with the reassignmentInProgress status until then. " +
+			"A terminal cannot be unassigned, so destroying this resource only removes it from the Terraform state.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Assign Terminal",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the terminal.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"terminal_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the terminal to reassign, e.g. V400m-080020970.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"company_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The unique identifier of the company account to reassign the terminal to the inventory of.",
+			},
+			"merchant_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The unique identifier of the merchant account to reassign the terminal to the inventory of.",
+			},
+			"store_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The unique identifier of the store to reassign the terminal to.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the assignment: inventory, boarded, deployed or reassignmentInProgress.",
+			},
+		},
+	}
+}
+
+// Create reassigns the terminal and sets the initial Terraform state.
+func (r *terminalAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen terminal assignment")
+
+	// Retrieve values from the plan
+	var plan terminalAssignmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	terminal, err := r.reassignTerminal(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating terminal assignment",
+			"Could not reassign terminal "+plan.TerminalID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = plan.TerminalID
+	plan.Status = types.StringValue(terminal.Assignment.Status)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *terminalAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state terminalAssignmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	terminal, err := getTerminal(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Terminal Assignment",
+			"Could not read terminal "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	// A terminal that the API credential no longer has access to cannot be reassigned anymore.
+	if terminal == nil || terminal.Assignment == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.TerminalID = state.ID
+	state = mapTerminalAssignmentResourceModel(*terminal.Assignment, state)
+
+	tflog.Debug(ctx, "Reading terminal assignment...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update reassigns the terminal and sets the updated Terraform state on success.
+func (r *terminalAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from the plan
+	var plan terminalAssignmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	terminal, err := r.reassignTerminal(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating terminal assignment",
+			"Could not reassign terminal "+plan.TerminalID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Status = types.StringValue(terminal.Assignment.Status)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the terminal assignment from the Terraform state. A terminal cannot be unassigned, so it stays where it was last reassigned to.
+func (r *terminalAssignmentResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing terminal assignment from state")
+}
+
+// ImportState imports the assignment of the terminal with the given identifier.
+func (r *terminalAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to the id attribute, Read fills in the remaining state.
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// reassignTerminal sends the reassignment in the plan to Adyen and returns the terminal with its new assignment.
+func (r *terminalAssignmentResource) reassignTerminal(ctx context.Context, plan terminalAssignmentResourceModel) (*management.Terminal, error) {
+	// Generate API request body from plan
+	reassignTerminalInput := r.client.Management().TerminalsTerminalLevelApi.ReassignTerminalInput(plan.TerminalID.ValueString()).
+		TerminalReassignmentRequest(mapTerminalReassignmentRequest(plan))
+	if _, err := r.client.Management().TerminalsTerminalLevelApi.ReassignTerminal(ctx, reassignTerminalInput); err != nil {
+		return nil, err
+	}
+
+	// The reassignment does not respond with a body, so the terminal is looked up for its new status.
+	terminal, err := getTerminal(ctx, r.client, plan.TerminalID.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	if terminal == nil || terminal.Assignment == nil {
+		return nil, fmt.Errorf("terminal %s was not found after reassigning it", plan.TerminalID.ValueString())
+	}
+	return terminal, nil
+}
+
+// getTerminal returns the terminal with the given identifier, or nil if the API credential has no access to such a terminal.
+func getTerminal(ctx context.Context, client *adyen.APIClient, terminalID string) (*management.Terminal, error) {
+	// There is no endpoint to get a single terminal, so the terminals are searched by ID, which also matches IDs containing it.
+	terminals, err := listTerminals(ctx, client, client.Management().TerminalsTerminalLevelApi.ListTerminalsInput().SearchQuery(terminalID))
+	if err != nil {
+		return nil, err
+	}
+	for _, terminal := range terminals {
+		if terminal.GetId() == terminalID {
+			return &terminal, nil
+		}
+	}
+	return nil, nil
+}
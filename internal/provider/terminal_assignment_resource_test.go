@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"testing"
+)
+
+func TestAccTerminalAssignmentResource(t *testing.T) {
+	resourceName := "adyen_terminal_assignment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalAssignment(`store_id = adyen_store.terminal.id`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "P400Plus-275039202"),
+					resource.TestCheckResourceAttr(resourceName, "terminal_id", "P400Plus-275039202"),
+					resource.TestCheckResourceAttrPair(resourceName, "store_id", "adyen_store.terminal", "id"),
+					resource.TestCheckNoResourceAttr(resourceName, "merchant_id"),
+					resource.TestCheckNoResourceAttr(resourceName, "company_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "boarded"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// A boarded terminal is reassigned at its next maintenance call, the target is shown in the meantime.
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalAssignment(`merchant_id = "WeaveAccountECOM"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "merchant_id", "WeaveAccountECOM"),
+					resource.TestCheckNoResourceAttr(resourceName, "store_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "reassignmentInProgress"),
+				),
+			},
+			{
+				SkipFunc: func() (bool, error) {
+					return testMockServer == nil, nil
+				},
+				PreConfig: func() {
+					testMockServer.completeTerminalReassignment("P400Plus-275039202")
+				},
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalAssignment(`merchant_id = "WeaveAccountECOM"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "merchant_id", "WeaveAccountECOM"),
+					resource.TestCheckResourceAttr(resourceName, "status", "inventory"),
+				),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalAssignment(`company_id = "WeaveAccount"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "company_id", "WeaveAccount"),
+					resource.TestCheckNoResourceAttr(resourceName, "merchant_id"),
+				),
+			},
+			{
+				// Reassigning the terminal outside of Terraform must show up as drift.
+				PreConfig: func() {
+					suite := new(AcceptanceSuite)
+					suite.SetupSuite()
+					client := suite.client
+
+					reassignTerminalInput := client.Management().TerminalsTerminalLevelApi.ReassignTerminalInput("P400Plus-275039202").
+						TerminalReassignmentRequest(management.TerminalReassignmentRequest{MerchantId: common.PtrString("WeaveAccountECOM"), Inventory: common.PtrBool(true)})
+					if _, err := client.Management().TerminalsTerminalLevelApi.ReassignTerminal(context.Background(), reassignTerminalInput); err != nil {
+						t.Fatalf("could not reassign terminal P400Plus-275039202: %s", err)
+					}
+				},
+				Config:             testProviderClientFromTmpl(t) + testConfigTerminalAssignment(`company_id = "WeaveAccount"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testConfigTerminalAssignment(target string) string {
+	return fmt.Sprintf(`
+	resource "adyen_store" "terminal" {
+		reference         = "TerraformTerminalStore"
+		description       = "Terraform terminal store"
+		shopper_statement = "Terraform Terminals"
+		phone_number      = "+31201234567"
+		address = {
+			country     = "NL"
+			line1       = "Simon Carmiggeltstraat 6-50"
+			city        = "Amsterdam"
+			postal_code = "1011 DJ"
+		}
+	}
+
+	resource "adyen_terminal_assignment" "test" {
+		terminal_id = "P400Plus-275039202"
+		%s
+	}
+`, target)
+}
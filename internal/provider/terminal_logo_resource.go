@@ -0,0 +1,385 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io/fs"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &terminalLogoResource{}
+	_ resource.ResourceWithConfigure        = &terminalLogoResource{}
+	_ resource.ResourceWithConfigValidators = &terminalLogoResource{}
+	_ resource.ResourceWithValidateConfig   = &terminalLogoResource{}
+	_ resource.ResourceWithModifyPlan       = &terminalLogoResource{}
+	_ resource.ResourceWithImportState      = &terminalLogoResource{}
+)
+
+// terminalLogoResource is the resource implementation.
+type terminalLogoResource struct {
+	client *adyen.APIClient
+}
+
+// NewTerminalLogoResource is a helper function to simplify the provider implementation.
+func NewTerminalLogoResource() resource.Resource {
+	return &terminalLogoResource{}
+}
+
+// terminalLogoResourceModel maps the "terminal_logo" schema data for a resource.
+type terminalLogoResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	CompanyID   types.String `tfsdk:"company_id"`
+	MerchantID  types.String `tfsdk:"merchant_id"`
+	StoreID     types.String `tfsdk:"store_id"`
+	TerminalID  types.String `tfsdk:"terminal_id"`
+	Model       types.String `tfsdk:"model"`
+	File        types.String `tfsdk:"file"`
+	Data        types.String `tfsdk:"data"`
+	ContentHash types.String `tfsdk:"content_hash"`
+}
+
+// terminalLogoID returns the identifier of a terminal logo, in the form "<level>/<id>/<model>", or "terminal/<id>" for a single terminal.
+func terminalLogoID(level terminalSettingsLevel, model string) string {
+	if level.name == "terminal" {
+		return level.String()
+	}
+	return level.String() + "/" + model
+}
+
+// terminalLogoHash returns the SHA-256 hash of a base64-encoded logo, so the logo does not have to be kept in state.
+// The decoded image is hashed, so differences in base64 formatting do not show up as drift.
+func terminalLogoHash(data string) string {
+	content, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		content = []byte(data)
+	}
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// terminalLogoData returns the base64-encoded logo, read from the local file if one is configured.
+func terminalLogoData(model terminalLogoResourceModel) (string, error) {
+	if model.File.IsNull() {
+		if _, err := base64.StdEncoding.DecodeString(model.Data.ValueString()); err != nil {
+			return "", fmt.Errorf("data is not a valid base64-encoded image: %w", err)
+		}
+		return model.Data.ValueString(), nil
+	}
+	content, err := os.ReadFile(model.File.ValueString())
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(content), nil
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *terminalLogoResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *terminalLogoResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terminal_logo"
+}
+
+// Schema defines the schema for the resource.
+func (r *terminalLogoResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := terminalSettingsLevelAttributes("terminal logo")
+	attributes["id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The identifier of the terminal logo, in the form <level>/<id>/<model>, e.g. merchant/WeaveAccountECOM/V400m, or terminal/<id> for a single terminal.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["model"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "The terminal model the logo applies to, e.g. V400m or S1F2. Required unless terminal_id is set, as a single terminal has only one model.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["file"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "The path to a local image file of the logo. The file may be created during the same apply. Exactly one of file and data must be set.",
+	}
+	attributes["data"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "The image of the logo, base64-encoded. Exactly one of file and data must be set.",
+	}
+	attributes["content_hash"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The SHA-256 hash of the logo image, used to detect changes to the file and to the logo in Adyen.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the terminal logo of a terminal model at a company account, merchant account or store, or of a single terminal. " +
+			"The logo applies to all terminals below the level, unless a different logo is configured at a lower level. " +
+			"Destroying this resource restores the logo inherited from the level above.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Terminal settings read and write",
+		Attributes: attributes,
+	}
+}
+
+// ConfigValidators returns the validations that span multiple attributes of the terminal logo.
+func (r *terminalLogoResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return append(terminalSettingsLevelConfigValidators(),
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("file"),
+			path.MatchRoot("data"),
+		),
+	)
+}
+
+// ValidateConfig checks that the terminal model is set for all levels except a single terminal.
+func (r *terminalLogoResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config terminalLogoResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.TerminalID.IsUnknown() || config.Model.IsUnknown() {
+		return
+	}
+
+	if config.TerminalID.IsNull() && config.Model.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("model"),
+			"Missing Attribute Configuration",
+			"model must be set for the terminal logo of a company account, merchant account or store.",
+		)
+	}
+	if !config.TerminalID.IsNull() && !config.Model.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("model"),
+			"Invalid Attribute Combination",
+			"model cannot be set for the terminal logo of a single terminal.",
+		)
+	}
+}
+
+// ModifyPlan computes the hash of the configured logo, so changes to a local file are planned as an update.
+// A file that does not exist yet may be created during the apply, so its hash is left unknown and the file is read by Create or Update.
+func (r *terminalLogoResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan terminalLogoResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.File.IsUnknown() || plan.Data.IsUnknown() {
+		return
+	}
+
+	data, err := terminalLogoData(plan)
+	if !plan.File.IsNull() && errors.Is(err, fs.ErrNotExist) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringUnknown())...)
+		return
+	}
+	if err != nil {
+		attribute := "file"
+		if plan.File.IsNull() {
+			attribute = "data"
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Terminal Logo",
+			"Could not read the terminal logo: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), terminalLogoHash(data))...)
+}
+
+// Create uploads the terminal logo and sets the initial Terraform state.
+func (r *terminalLogoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen terminal logo")
+
+	// Retrieve values from the plan
+	var plan terminalLogoResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	level := newTerminalSettingsLevel(plan.CompanyID, plan.MerchantID, plan.StoreID, plan.TerminalID)
+	plan.ID = types.StringValue(terminalLogoID(level, plan.Model.ValueString()))
+	if err := r.uploadLogo(ctx, level, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating terminal logo",
+			"Could not upload terminal logo "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to the uploaded terminal logo
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *terminalLogoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state terminalLogoResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	level := newTerminalSettingsLevel(state.CompanyID, state.MerchantID, state.StoreID, state.TerminalID)
+	logo, httpRes, err := level.getLogo(ctx, r.client, state.Model.ValueString())
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the account, store or terminal does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Terminal Logo",
+			"Could not read terminal logo "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if logo.GetData() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only the hash is refreshed, so a logo changed outside of Terraform shows up as drift.
+	state.ContentHash = types.StringValue(terminalLogoHash(logo.GetData()))
+
+	tflog.Debug(ctx, "Reading terminal logo...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update uploads the changed terminal logo and sets the updated Terraform state on success.
+func (r *terminalLogoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating adyen terminal logo")
+
+	// Retrieve values from the plan
+	var plan terminalLogoResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	level := newTerminalSettingsLevel(plan.CompanyID, plan.MerchantID, plan.StoreID, plan.TerminalID)
+	if err := r.uploadLogo(ctx, level, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating terminal logo",
+			"Could not upload terminal logo "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete restores the terminal logo inherited from the level above and removes the Terraform state on success.
+func (r *terminalLogoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state terminalLogoResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An empty logo restores the logo inherited from the level above.
+	level := newTerminalSettingsLevel(state.CompanyID, state.MerchantID, state.StoreID, state.TerminalID)
+	_, httpRes, err := level.updateLogo(ctx, r.client, state.Model.ValueString(), management.Logo{Data: common.PtrString("")})
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // The account, store or terminal no longer exists.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Terminal Logo",
+			"Could not restore inherited terminal logo "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a terminal logo using an identifier of the form "<level>/<id>/<model>", e.g. "merchant/WeaveAccountECOM/V400m",
+// or "terminal/<id>" for a single terminal. The file or data is not imported, only the hash of the logo.
+func (r *terminalLogoResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	attribute, ok := terminalSettingsLevels[parts[0]]
+	expectedParts := 3
+	if parts[0] == "terminal" {
+		expectedParts = 2
+	}
+	if !ok || len(parts) != expectedParts || parts[1] == "" || parts[expectedParts-1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import identifier of the form '<level>/<id>/<model>' with level company, merchant or store, or 'terminal/<id>', got '%s'.", req.ID),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the level and model attributes, Read fills in the hash.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), parts[1])...)
+	if expectedParts == 3 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("model"), parts[2])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// uploadLogo uploads the configured terminal logo and sets the hash of the uploaded logo in the model.
+func (r *terminalLogoResource) uploadLogo(ctx context.Context, level terminalSettingsLevel, plan *terminalLogoResourceModel) error {
+	data, err := terminalLogoData(*plan)
+	if err != nil {
+		return err
+	}
+	if _, _, err := level.updateLogo(ctx, r.client, plan.Model.ValueString(), management.Logo{Data: &data}); err != nil {
+		return err
+	}
+	plan.ContentHash = types.StringValue(terminalLogoHash(data))
+	return nil
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+func TestAccTerminalLogoResource(t *testing.T) {
+	merchantLogo := "adyen_terminal_logo.merchant"
+	terminalLogo := "adyen_terminal_logo.terminal"
+	logoFile := filepath.Join(t.TempDir(), "logo.png")
+	writeLogo := func(content string) {
+		if err := os.WriteFile(logoFile, []byte(content), 0o600); err != nil {
+			t.Fatalf("could not write logo file: %s", err)
+		}
+	}
+	writeLogo("terraform logo")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenTerminalLogoDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + `
+				resource "adyen_terminal_logo" "invalid" {
+					merchant_id = "WeaveAccountECOM"
+					data        = "dGVycmFmb3Jt"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`model must be set`),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalLogo(logoFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(merchantLogo, "id", "merchant/WeaveAccountECOM/V400m"),
+					resource.TestCheckResourceAttr(merchantLogo, "content_hash", terminalLogoHash(base64.StdEncoding.EncodeToString([]byte("terraform logo")))),
+					resource.TestCheckResourceAttr(terminalLogo, "id", "terminal/V400m-080020970"),
+					resource.TestCheckResourceAttr(terminalLogo, "content_hash", terminalLogoHash("dGVycmFmb3Jt")),
+				),
+			},
+			{
+				ResourceName:            merchantLogo,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file"},
+			},
+			{
+				ResourceName:            terminalLogo,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data"},
+			},
+			{
+				// Changing the content of the local file must update the logo.
+				PreConfig: func() {
+					writeLogo("refreshed terraform logo")
+				},
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalLogo(logoFile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(merchantLogo, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(terminalLogo, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(merchantLogo, "content_hash", terminalLogoHash(base64.StdEncoding.EncodeToString([]byte("refreshed terraform logo")))),
+			},
+			{
+				// Changing the logo outside of Terraform must show up as drift.
+				PreConfig: func() {
+					suite := new(AcceptanceSuite)
+					suite.SetupSuite()
+					client := suite.client
+
+					data := client.Management().TerminalSettingsTerminalLevelApi.UpdateLogoInput("V400m-080020970").Logo(management.Logo{Data: common.PtrString("b3RoZXI=")})
+					if _, _, err := client.Management().TerminalSettingsTerminalLevelApi.UpdateLogo(context.Background(), data); err != nil {
+						t.Fatalf("could not update terminal logo: %s", err)
+					}
+				},
+				Config:             testProviderClientFromTmpl(t) + testConfigTerminalLogo(logoFile),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTerminalLogoResourceGeneratedFile(t *testing.T) {
+	resourceName := "adyen_terminal_logo.generated"
+	logoFile := filepath.Join(t.TempDir(), "generated.png")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenTerminalLogoDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				// The file does not exist when the plan is made, as it is only written during the apply.
+				Config: testProviderClientFromTmpl(t) + fmt.Sprintf(`
+				resource "terraform_data" "logo" {
+					provisioner "local-exec" {
+						command = "printf 'generated logo' > %[1]s"
+					}
+				}
+
+				resource "adyen_terminal_logo" "generated" {
+					merchant_id = "WeaveAccountECOM"
+					model       = "S1F2"
+					file        = "%[1]s"
+					depends_on  = [terraform_data.logo]
+				}
+				`, logoFile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("content_hash")),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "content_hash", terminalLogoHash(base64.StdEncoding.EncodeToString([]byte("generated logo")))),
+			},
+		},
+	})
+}
+
+func testAccCheckAdyenTerminalLogoDestroy(tfstate *terraform.State) error {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	for _, rs := range tfstate.RootModule().Resources {
+		if rs.Type != "adyen_terminal_logo" {
+			continue
+		}
+
+		var level terminalSettingsLevel
+		for name, attribute := range terminalSettingsLevels {
+			if id := rs.Primary.Attributes[attribute]; id != "" {
+				level = terminalSettingsLevel{name: name, id: id}
+			}
+		}
+		logo, _, err := level.getLogo(context.Background(), client, rs.Primary.Attributes["model"])
+		if err != nil {
+			return err
+		}
+		if logo.GetData() != "" {
+			return fmt.Errorf("adyen_terminal_logo with id: '%s' still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testConfigTerminalLogo(logoFile string) string {
+	return fmt.Sprintf(`
+	resource "adyen_terminal_logo" "merchant" {
+		merchant_id = "WeaveAccountECOM"
+		model       = "V400m"
+		file        = "%s"
+	}
+
+	resource "adyen_terminal_logo" "terminal" {
+		terminal_id = "V400m-080020970"
+		data        = "dGVycmFmb3Jt"
+	}
+`, logoFile)
+}
@@ -0,0 +1,518 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &terminalOrderResource{}
+	_ resource.ResourceWithConfigure        = &terminalOrderResource{}
+	_ resource.ResourceWithConfigValidators = &terminalOrderResource{}
+	_ resource.ResourceWithImportState      = &terminalOrderResource{}
+)
+
+// Statuses of a terminal order. Orders can only be updated and cancelled while they are placed.
+const (
+	terminalOrderStatusPlaced    = "Placed"
+	terminalOrderStatusCancelled = "Cancelled"
+)
+
+// terminalOrderImportedKey is the private state key that marks an imported terminal order until its first update,
+// so the order type and tax number Adyen does not return are taken from the configuration.
+const terminalOrderImportedKey = "imported"
+
+// terminalOrderResource is the resource implementation.
+type terminalOrderResource struct {
+	client *adyen.APIClient
+}
+
+// NewTerminalOrderResource is a helper function to simplify the provider implementation.
+func NewTerminalOrderResource() resource.Resource {
+	return &terminalOrderResource{}
+}
+
+// terminalOrderResourceModel maps the "terminal_order" schema data for a resource.
+type terminalOrderResourceModel struct {
+	ID                     types.String             `tfsdk:"id"`
+	CompanyID              types.String             `tfsdk:"company_id"`
+	MerchantID             types.String             `tfsdk:"merchant_id"`
+	BillingEntityID        types.String             `tfsdk:"billing_entity_id"`
+	ShippingLocationID     types.String             `tfsdk:"shipping_location_id"`
+	CustomerOrderReference types.String             `tfsdk:"customer_order_reference"`
+	OrderType              types.String             `tfsdk:"order_type"`
+	TaxID                  types.String             `tfsdk:"tax_id"`
+	Items                  []terminalOrderItemModel `tfsdk:"items"`
+	Status                 types.String             `tfsdk:"status"`
+	OrderDate              types.String             `tfsdk:"order_date"`
+	TrackingURL            types.String             `tfsdk:"tracking_url"`
+}
+
+// terminalOrderItemModel maps a product of a terminal order.
+type terminalOrderItemModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Quantity     types.Int64  `tfsdk:"quantity"`
+	Installments types.Int64  `tfsdk:"installments"`
+}
+
+// mapTerminalOrderRequest maps a terminal order plan to an Adyen API request.
+func mapTerminalOrderRequest(plan terminalOrderResourceModel) management.TerminalOrderRequest {
+	request := management.TerminalOrderRequest{
+		BillingEntityId:        plan.BillingEntityID.ValueStringPointer(),
+		ShippingLocationId:     plan.ShippingLocationID.ValueStringPointer(),
+		CustomerOrderReference: plan.CustomerOrderReference.ValueStringPointer(),
+		OrderType:              plan.OrderType.ValueStringPointer(),
+		TaxId:                  plan.TaxID.ValueStringPointer(),
+		Items:                  []management.OrderItem{},
+	}
+	for _, item := range plan.Items {
+		request.Items = append(request.Items, management.OrderItem{
+			Id:           item.ID.ValueStringPointer(),
+			Name:         knownStringPointer(item.Name),
+			Quantity:     int32Pointer(item.Quantity),
+			Installments: item.Installments.ValueInt64Pointer(),
+		})
+	}
+	return request
+}
+
+// terminalOrderChanged reports whether an update request changes the terminal order in state.
+// Product names left out of the configuration are filled in by Adyen, so they do not count as a change.
+func terminalOrderChanged(request management.TerminalOrderRequest, state terminalOrderResourceModel) bool {
+	current := mapTerminalOrderRequest(state)
+	for i := range current.Items {
+		if i < len(request.Items) && request.Items[i].Name == nil {
+			current.Items[i].Name = nil
+		}
+	}
+	return !reflect.DeepEqual(request, current)
+}
+
+// mapTerminalOrderResourceModel maps a terminal order returned by the Adyen API to the Terraform model.
+// Adyen does not return the order type and tax number, so these keep their configured values.
+func mapTerminalOrderResourceModel(order management.TerminalOrder, current terminalOrderResourceModel) terminalOrderResourceModel {
+	model := terminalOrderResourceModel{
+		ID:                     types.StringPointerValue(order.Id),
+		CompanyID:              current.CompanyID,
+		MerchantID:             current.MerchantID,
+		CustomerOrderReference: types.StringPointerValue(order.CustomerOrderReference),
+		OrderType:              current.OrderType,
+		TaxID:                  current.TaxID,
+		Items:                  []terminalOrderItemModel{},
+		Status:                 types.StringPointerValue(order.Status),
+		OrderDate:              types.StringPointerValue(order.OrderDate),
+		TrackingURL:            types.StringPointerValue(order.TrackingUrl),
+	}
+	if order.BillingEntity != nil {
+		model.BillingEntityID = types.StringPointerValue(order.BillingEntity.Id)
+	}
+	if order.ShippingLocation != nil {
+		model.ShippingLocationID = types.StringPointerValue(order.ShippingLocation.Id)
+	}
+	for _, item := range order.Items {
+		mapped := terminalOrderItemModel{
+			ID:           types.StringPointerValue(item.Id),
+			Name:         types.StringPointerValue(item.Name),
+			Installments: types.Int64PointerValue(item.Installments),
+			Quantity:     types.Int64Null(),
+		}
+		if item.Quantity != nil {
+			mapped.Quantity = types.Int64Value(int64(*item.Quantity))
+		}
+		model.Items = append(model.Items, mapped)
+	}
+	return model
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *terminalOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *terminalOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terminal_order"
+}
+
+// Schema defines the schema for the resource.
+func (r *terminalOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an order of payment terminal products for a company or merchant account. " +
+			"Orders can only be changed while they have the status Placed. Destroying this resource cancels the order, " +
+			"which fails once the order has been confirmed or shipped.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Terminal ordering read and write",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the order.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"company_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The unique identifier of the company account to order for. Conflicts with merchant_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"merchant_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the merchant account to order for. Defaults to the merchant account of the provider, unless company_id is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"billing_entity_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the billing entity to use for the order.",
+			},
+			"shipping_location_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the shipping location to deliver the order to.",
+			},
+			"customer_order_reference": schema.StringAttribute{
+				Optional:    true,
+				Description: "Your purchase order reference.",
+			},
+			"order_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The type of order, e.g. Sale or Rental. Adyen does not return the order type, so after an import it is taken from the configuration.",
+			},
+			"tax_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The tax number of the billing entity. Adyen does not return the tax number, so after an import it is taken from the configuration.",
+			},
+			"items": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The products to order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "The unique identifier of the product.",
+						},
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The name of the product.",
+						},
+						"quantity": schema.Int64Attribute{
+							Required:    true,
+							Description: "The number of items of the product to order.",
+						},
+						"installments": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The number of installments to pay the product in.",
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The processing status of the order: Placed, Confirmed, Cancelled, Shipped or Delivered.",
+			},
+			"order_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date and time the order was placed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tracking_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL to track the shipment of the order.",
+			},
+		},
+	}
+}
+
+// ConfigValidators returns the validations that span multiple attributes of the terminal order.
+func (r *terminalOrderResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("company_id"),
+			path.MatchRoot("merchant_id"),
+		),
+	}
+}
+
+// Create places the terminal order and sets the initial Terraform state.
+func (r *terminalOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen terminal order")
+
+	// Retrieve values from the plan
+	var plan terminalOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var order management.TerminalOrder
+	var err error
+	if !plan.CompanyID.IsNull() {
+		plan.MerchantID = types.StringNull()
+		createOrderInput := r.client.Management().TerminalOrdersCompanyLevelApi.CreateOrderInput(plan.CompanyID.ValueString()).TerminalOrderRequest(mapTerminalOrderRequest(plan))
+		order, _, err = r.client.Management().TerminalOrdersCompanyLevelApi.CreateOrder(ctx, createOrderInput)
+	} else {
+		if plan.MerchantID.IsUnknown() {
+			plan.MerchantID = types.StringValue(r.client.GetConfig().MerchantAccount)
+		}
+		createOrderInput := r.client.Management().TerminalOrdersMerchantLevelApi.CreateOrderInput(plan.MerchantID.ValueString()).TerminalOrderRequest(mapTerminalOrderRequest(plan))
+		order, _, err = r.client.Management().TerminalOrdersMerchantLevelApi.CreateOrder(ctx, createOrderInput)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating terminal order",
+			"Could not create terminal order, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan = mapTerminalOrderResourceModel(order, plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *terminalOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state terminalOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, httpRes, err := r.getOrder(ctx, state)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the order does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Terminal Order",
+			"Could not read terminal order "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// An order cancelled outside of Terraform is gone, so it is placed again on the next apply.
+	if strings.EqualFold(order.GetStatus(), terminalOrderStatusCancelled) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = mapTerminalOrderResourceModel(order, state)
+
+	tflog.Debug(ctx, "Reading terminal order...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the terminal order and sets the updated Terraform state on success.
+func (r *terminalOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating adyen terminal order")
+
+	// Retrieve values from the plan
+	var plan terminalOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state terminalOrderResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imported, diags := req.Private.GetKey(ctx, terminalOrderImportedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	request := mapTerminalOrderRequest(plan)
+	if imported != nil {
+		// The order type and tax number of an imported order are unknown, so the configured values are adopted instead of sent to Adyen.
+		if state.OrderType.IsNull() {
+			request.OrderType = nil
+		}
+		if state.TaxID.IsNull() {
+			request.TaxId = nil
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, terminalOrderImportedKey, nil)...)
+		if !terminalOrderChanged(request, state) {
+			state.OrderType = plan.OrderType
+			state.TaxID = plan.TaxID
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+	}
+
+	if !strings.EqualFold(state.Status.ValueString(), terminalOrderStatusPlaced) {
+		resp.Diagnostics.AddError(
+			"Error updating terminal order",
+			fmt.Sprintf("Terminal order %s has the status %s and can no longer be changed. Only orders with the status %s can be updated.", state.ID.ValueString(), state.Status.ValueString(), terminalOrderStatusPlaced),
+		)
+		return
+	}
+
+	var order management.TerminalOrder
+	var err error
+	if !plan.CompanyID.IsNull() {
+		updateOrderInput := r.client.Management().TerminalOrdersCompanyLevelApi.UpdateOrderInput(plan.CompanyID.ValueString(), state.ID.ValueString()).TerminalOrderRequest(request)
+		order, _, err = r.client.Management().TerminalOrdersCompanyLevelApi.UpdateOrder(ctx, updateOrderInput)
+	} else {
+		updateOrderInput := r.client.Management().TerminalOrdersMerchantLevelApi.UpdateOrderInput(plan.MerchantID.ValueString(), state.ID.ValueString()).TerminalOrderRequest(request)
+		order, _, err = r.client.Management().TerminalOrdersMerchantLevelApi.UpdateOrder(ctx, updateOrderInput)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating terminal order",
+			"Could not update terminal order "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan = mapTerminalOrderResourceModel(order, plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete cancels the terminal order and removes the Terraform state on success.
+// Orders that are no longer placed cannot be cancelled, so they stay in state.
+func (r *terminalOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state terminalOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, httpRes, err := r.getOrder(ctx, state)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // The order no longer exists, so there is nothing to cancel.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Terminal Order",
+			"Could not read terminal order "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	switch {
+	case strings.EqualFold(order.GetStatus(), terminalOrderStatusCancelled):
+		return
+	case !strings.EqualFold(order.GetStatus(), terminalOrderStatusPlaced):
+		resp.Diagnostics.AddError(
+			"Error Deleting Terminal Order",
+			fmt.Sprintf("Terminal order %s has the status %s and can no longer be cancelled. "+
+				"Only orders with the status %s can be cancelled; to stop managing this order, remove it from the state with terraform state rm.",
+				state.ID.ValueString(), order.GetStatus(), terminalOrderStatusPlaced),
+		)
+		return
+	}
+
+	if !state.CompanyID.IsNull() {
+		cancelOrderInput := r.client.Management().TerminalOrdersCompanyLevelApi.CancelOrderInput(state.CompanyID.ValueString(), state.ID.ValueString())
+		_, _, err = r.client.Management().TerminalOrdersCompanyLevelApi.CancelOrder(ctx, cancelOrderInput)
+	} else {
+		cancelOrderInput := r.client.Management().TerminalOrdersMerchantLevelApi.CancelOrderInput(state.MerchantID.ValueString(), state.ID.ValueString())
+		_, _, err = r.client.Management().TerminalOrdersMerchantLevelApi.CancelOrder(ctx, cancelOrderInput)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Terminal Order",
+			"Could not cancel terminal order "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a terminal order using an identifier of the form "<merchant_id>/<order_id>",
+// or "company/<company_id>/<order_id>" for an order of a company account.
+func (r *terminalOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("merchant_id"), parts[0])...)
+	case len(parts) == 3 && parts[0] == "company" && parts[1] != "" && parts[2] != "":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("company_id"), parts[1])...)
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import identifier of the form '<merchant_id>/<order_id>' or 'company/<company_id>/<order_id>', got '%s'.", req.ID),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the id attribute, Read fills in the remaining state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[len(parts)-1])...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, terminalOrderImportedKey, []byte("true"))...)
+}
+
+// getOrder returns the terminal order of the company or merchant account in the model.
+func (r *terminalOrderResource) getOrder(ctx context.Context, model terminalOrderResourceModel) (management.TerminalOrder, *http.Response, error) {
+	if !model.CompanyID.IsNull() {
+		getOrderInput := r.client.Management().TerminalOrdersCompanyLevelApi.GetOrderInput(model.CompanyID.ValueString(), model.ID.ValueString())
+		return r.client.Management().TerminalOrdersCompanyLevelApi.GetOrder(ctx, getOrderInput)
+	}
+	getOrderInput := r.client.Management().TerminalOrdersMerchantLevelApi.GetOrderInput(model.MerchantID.ValueString(), model.ID.ValueString())
+	return r.client.Management().TerminalOrdersMerchantLevelApi.GetOrder(ctx, getOrderInput)
+}
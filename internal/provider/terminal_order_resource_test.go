@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAccTerminalOrderResource(t *testing.T) {
+	merchantOrder := "adyen_terminal_order.merchant"
+	companyOrder := "adyen_terminal_order.company"
+	var companyOrderID string
+
+	// Shipping an order cannot be done through the API, so those steps only run against the mock server.
+	skipWithoutMockServer := func() (bool, error) {
+		return testMockServer == nil, nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenTerminalOrderDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalOrder(2, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(merchantOrder, "id"),
+					resource.TestCheckResourceAttr(merchantOrder, "merchant_id", "WeaveAccountECOM"),
+					resource.TestCheckResourceAttr(merchantOrder, "status", "Placed"),
+					resource.TestCheckResourceAttrSet(merchantOrder, "order_date"),
+					resource.TestCheckResourceAttr(merchantOrder, "items.0.name", "V400m Package"),
+					resource.TestCheckResourceAttr(merchantOrder, "items.0.quantity", "2"),
+					resource.TestCheckResourceAttr(merchantOrder, "order_type", "Sale"),
+					resource.TestCheckResourceAttr(merchantOrder, "tax_id", "NL123456789B01"),
+					resource.TestCheckResourceAttr(companyOrder, "company_id", "WeaveAccount"),
+					resource.TestCheckNoResourceAttr(companyOrder, "merchant_id"),
+					resource.TestCheckResourceAttr(companyOrder, "status", "Placed"),
+					func(s *terraform.State) error {
+						companyOrderID = s.RootModule().Resources[companyOrder].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      merchantOrder,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Adyen does not return the order type and tax number.
+				ImportStateVerifyIgnore: []string{"order_type", "tax_id"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[merchantOrder]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", merchantOrder)
+					}
+					return rs.Primary.Attributes["merchant_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				ResourceName:      companyOrder,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[companyOrder]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", companyOrder)
+					}
+					return "company/" + rs.Primary.Attributes["company_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalOrder(3, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(merchantOrder, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(merchantOrder, "items.0.quantity", "3"),
+			},
+			{
+				// An order that has shipped cannot be cancelled, so it must stay in state.
+				SkipFunc: skipWithoutMockServer,
+				PreConfig: func() {
+					testMockServer.setTerminalOrderStatus(companyOrderID, "Shipped")
+				},
+				Config:      testProviderClientFromTmpl(t) + testConfigTerminalOrder(3, false),
+				ExpectError: regexp.MustCompile(`has the status Shipped and can no longer be\s+cancelled`),
+			},
+			{
+				// An order cancelled outside of Terraform is removed from state.
+				SkipFunc: skipWithoutMockServer,
+				PreConfig: func() {
+					testMockServer.setTerminalOrderStatus(companyOrderID, "Cancelled")
+				},
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalOrder(3, false),
+				Check: func(s *terraform.State) error {
+					if _, ok := s.RootModule().Resources[companyOrder]; ok {
+						return fmt.Errorf("%s is still in state", companyOrder)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccTerminalOrderResourceImport(t *testing.T) {
+	resourceName := "adyen_terminal_order.imported"
+	var importID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenTerminalOrderDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalOrderImported(false),
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources[resourceName]
+					importID = rs.Primary.Attributes["merchant_id"] + "/" + rs.Primary.ID
+					return nil
+				},
+			},
+			{
+				// Forget the order without cancelling it, so it can be imported again.
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalOrderImported(true),
+			},
+			{
+				ResourceName:       resourceName,
+				Config:             testProviderClientFromTmpl(t) + testConfigTerminalOrderImported(false),
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return importID, nil
+				},
+			},
+			{
+				// Adyen does not return the order type and tax number, so the imported order adopts them instead of being replaced.
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalOrderImported(false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "order_type", "Sale"),
+					resource.TestCheckResourceAttr(resourceName, "tax_id", "NL123456789B01"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Placed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAdyenTerminalOrderDestroy(tfstate *terraform.State) error {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	for _, rs := range tfstate.RootModule().Resources {
+		if rs.Type != "adyen_terminal_order" {
+			continue
+		}
+
+		// Adyen does not delete orders, so destroying the resource cancels the order.
+		var status string
+		if companyID := rs.Primary.Attributes["company_id"]; companyID != "" {
+			data := client.Management().TerminalOrdersCompanyLevelApi.GetOrderInput(companyID, rs.Primary.ID)
+			order, _, err := client.Management().TerminalOrdersCompanyLevelApi.GetOrder(context.Background(), data)
+			if err != nil {
+				return err
+			}
+			status = order.GetStatus()
+		} else {
+			data := client.Management().TerminalOrdersMerchantLevelApi.GetOrderInput(rs.Primary.Attributes["merchant_id"], rs.Primary.ID)
+			order, _, err := client.Management().TerminalOrdersMerchantLevelApi.GetOrder(context.Background(), data)
+			if err != nil {
+				return err
+			}
+			status = order.GetStatus()
+		}
+		if !strings.EqualFold(status, "Cancelled") {
+			return fmt.Errorf("adyen_terminal_order with id: '%s' has status %s instead of Cancelled", rs.Primary.ID, status)
+		}
+	}
+	return nil
+}
+
+func testConfigTerminalOrder(quantity int, companyOrder bool) string {
+	config := fmt.Sprintf(`
+	resource "adyen_terminal_order" "merchant" {
+		billing_entity_id        = "MerchantAccount.WeaveAccountECOM"
+		shipping_location_id     = "S2-232A6D2B7C3D"
+		customer_order_reference = "Terraform merchant order"
+		order_type               = "Sale"
+		tax_id                   = "NL123456789B01"
+		items = [
+			{
+				id       = "TBOX-V400m-684-EU"
+				quantity = %d
+			},
+		]
+	}
+`, quantity)
+	if companyOrder {
+		config += `
+	resource "adyen_terminal_order" "company" {
+		company_id           = "WeaveAccount"
+		billing_entity_id    = "Company.WeaveAccount"
+		shipping_location_id = "S2-6A6C2E3432747D4F2F2C3455485E3836457D"
+		items = [
+			{
+				id       = "TBOX-S1F2-EU"
+				name     = "S1F2 Package"
+				quantity = 1
+			},
+		]
+	}
+`
+	}
+	return config
+}
+
+// testConfigTerminalOrderImported configures a merchant order with an order type and tax number, or removes it from state without cancelling it if removed is set.
+func testConfigTerminalOrderImported(removed bool) string {
+	if removed {
+		return `
+	removed {
+		from = adyen_terminal_order.imported
+		lifecycle {
+			destroy = false
+		}
+	}
+`
+	}
+	return `
+	resource "adyen_terminal_order" "imported" {
+		billing_entity_id    = "MerchantAccount.WeaveAccountECOM"
+		shipping_location_id = "S2-232A6D2B7C3D"
+		order_type           = "Sale"
+		tax_id               = "NL123456789B01"
+		items = [
+			{
+				id       = "TBOX-V400m-684-EU"
+				quantity = 1
+			},
+		]
+	}
+`
+}
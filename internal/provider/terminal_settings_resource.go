@@ -0,0 +1,1109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &terminalSettingsResource{}
+	_ resource.ResourceWithConfigure        = &terminalSettingsResource{}
+	_ resource.ResourceWithConfigValidators = &terminalSettingsResource{}
+	_ resource.ResourceWithImportState      = &terminalSettingsResource{}
+)
+
+// terminalSettingsImportedKey is the private state key that marks terminal settings that were just imported,
+// so the first read takes all settings from Adyen instead of only the configured ones.
+const terminalSettingsImportedKey = "imported"
+
+// terminalSettingsResource is the resource implementation.
+type terminalSettingsResource struct {
+	client *adyen.APIClient
+}
+
+// NewTerminalSettingsResource is a helper function to simplify the provider implementation.
+func NewTerminalSettingsResource() resource.Resource {
+	return &terminalSettingsResource{}
+}
+
+// terminalSettingsLevel is the company account, merchant account, store or terminal that terminal settings apply to.
+type terminalSettingsLevel struct {
+	name string // "company", "merchant", "store" or "terminal"
+	id   string
+}
+
+// terminalSettingsLevels are the attributes that select the level of terminal settings, by level name.
+var terminalSettingsLevels = map[string]string{
+	"company":  "company_id",
+	"merchant": "merchant_id",
+	"store":    "store_id",
+	"terminal": "terminal_id",
+}
+
+// newTerminalSettingsLevel returns the level selected by the one level attribute that is set.
+func newTerminalSettingsLevel(companyID, merchantID, storeID, terminalID types.String) terminalSettingsLevel {
+	switch {
+	case !companyID.IsNull():
+		return terminalSettingsLevel{name: "company", id: companyID.ValueString()}
+	case !merchantID.IsNull():
+		return terminalSettingsLevel{name: "merchant", id: merchantID.ValueString()}
+	case !storeID.IsNull():
+		return terminalSettingsLevel{name: "store", id: storeID.ValueString()}
+	default:
+		return terminalSettingsLevel{name: "terminal", id: terminalID.ValueString()}
+	}
+}
+
+// String returns the identifier of the level, in the form "<level>/<id>".
+func (l terminalSettingsLevel) String() string {
+	return l.name + "/" + l.id
+}
+
+// getSettings returns the terminal settings of the level, including the settings inherited from the levels above.
+func (l terminalSettingsLevel) getSettings(ctx context.Context, client *adyen.APIClient) (management.TerminalSettings, *http.Response, error) {
+	switch l.name {
+	case "company":
+		return client.Management().TerminalSettingsCompanyLevelApi.GetTerminalSettings(ctx, client.Management().TerminalSettingsCompanyLevelApi.GetTerminalSettingsInput(l.id))
+	case "merchant":
+		return client.Management().TerminalSettingsMerchantLevelApi.GetTerminalSettings(ctx, client.Management().TerminalSettingsMerchantLevelApi.GetTerminalSettingsInput(l.id))
+	case "store":
+		return client.Management().TerminalSettingsStoreLevelApi.GetTerminalSettingsByStoreId(ctx, client.Management().TerminalSettingsStoreLevelApi.GetTerminalSettingsByStoreIdInput(l.id))
+	default:
+		return client.Management().TerminalSettingsTerminalLevelApi.GetTerminalSettings(ctx, client.Management().TerminalSettingsTerminalLevelApi.GetTerminalSettingsInput(l.id))
+	}
+}
+
+// updateSettings updates the terminal settings of the level. Only the settings in the request are changed.
+func (l terminalSettingsLevel) updateSettings(ctx context.Context, client *adyen.APIClient, settings management.TerminalSettings) (management.TerminalSettings, *http.Response, error) {
+	switch l.name {
+	case "company":
+		return client.Management().TerminalSettingsCompanyLevelApi.UpdateTerminalSettings(ctx, client.Management().TerminalSettingsCompanyLevelApi.UpdateTerminalSettingsInput(l.id).TerminalSettings(settings))
+	case "merchant":
+		return client.Management().TerminalSettingsMerchantLevelApi.UpdateTerminalSettings(ctx, client.Management().TerminalSettingsMerchantLevelApi.UpdateTerminalSettingsInput(l.id).TerminalSettings(settings))
+	case "store":
+		return client.Management().TerminalSettingsStoreLevelApi.UpdateTerminalSettingsByStoreId(ctx, client.Management().TerminalSettingsStoreLevelApi.UpdateTerminalSettingsByStoreIdInput(l.id).TerminalSettings(settings))
+	default:
+		return client.Management().TerminalSettingsTerminalLevelApi.UpdateTerminalSettings(ctx, client.Management().TerminalSettingsTerminalLevelApi.UpdateTerminalSettingsInput(l.id).TerminalSettings(settings))
+	}
+}
+
+// getLogo returns the terminal logo of a terminal model at the level. The model is ignored for a single terminal.
+func (l terminalSettingsLevel) getLogo(ctx context.Context, client *adyen.APIClient, model string) (management.Logo, *http.Response, error) {
+	switch l.name {
+	case "company":
+		return client.Management().TerminalSettingsCompanyLevelApi.GetTerminalLogo(ctx, client.Management().TerminalSettingsCompanyLevelApi.GetTerminalLogoInput(l.id).Model(model))
+	case "merchant":
+		return client.Management().TerminalSettingsMerchantLevelApi.GetTerminalLogo(ctx, client.Management().TerminalSettingsMerchantLevelApi.GetTerminalLogoInput(l.id).Model(model))
+	case "store":
+		return client.Management().TerminalSettingsStoreLevelApi.GetTerminalLogoByStoreId(ctx, client.Management().TerminalSettingsStoreLevelApi.GetTerminalLogoByStoreIdInput(l.id).Model(model))
+	default:
+		return client.Management().TerminalSettingsTerminalLevelApi.GetTerminalLogo(ctx, client.Management().TerminalSettingsTerminalLevelApi.GetTerminalLogoInput(l.id))
+	}
+}
+
+// updateLogo updates the terminal logo of a terminal model at the level. The model is ignored for a single terminal.
+// An empty logo restores the logo inherited from the level above.
+func (l terminalSettingsLevel) updateLogo(ctx context.Context, client *adyen.APIClient, model string, logo management.Logo) (management.Logo, *http.Response, error) {
+	switch l.name {
+	case "company":
+		return client.Management().TerminalSettingsCompanyLevelApi.UpdateTerminalLogo(ctx, client.Management().TerminalSettingsCompanyLevelApi.UpdateTerminalLogoInput(l.id).Model(model).Logo(logo))
+	case "merchant":
+		return client.Management().TerminalSettingsMerchantLevelApi.UpdateTerminalLogo(ctx, client.Management().TerminalSettingsMerchantLevelApi.UpdateTerminalLogoInput(l.id).Model(model).Logo(logo))
+	case "store":
+		return client.Management().TerminalSettingsStoreLevelApi.UpdateTerminalLogoByStoreId(ctx, client.Management().TerminalSettingsStoreLevelApi.UpdateTerminalLogoByStoreIdInput(l.id).Model(model).Logo(logo))
+	default:
+		return client.Management().TerminalSettingsTerminalLevelApi.UpdateLogo(ctx, client.Management().TerminalSettingsTerminalLevelApi.UpdateLogoInput(l.id).Logo(logo))
+	}
+}
+
+// terminalSettingsLevelAttributes returns the schema attributes that select the level of terminal settings, of which exactly one must be set.
+func terminalSettingsLevelAttributes(subject string) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{}
+	for name, description := range map[string]string{
+		"company_id":  "The unique identifier of the company account to configure the " + subject + " of.",
+		"merchant_id": "The unique identifier of the merchant account to configure the " + subject + " of.",
+		"store_id":    "The unique identifier of the store to configure the " + subject + " of.",
+		"terminal_id": "The unique identifier of the terminal to configure the " + subject + " of, e.g. V400m-080020970.",
+	} {
+		attributes[name] = schema.StringAttribute{
+			Optional:    true,
+			Description: description + " Exactly one of company_id, merchant_id, store_id and terminal_id must be set.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	attributes["id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The level the " + subject + " apply to, in the form <level>/<id>, e.g. merchant/WeaveAccountECOM.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	return attributes
+}
+
+// terminalSettingsLevelConfigValidators returns the validations that make sure exactly one level is selected.
+func terminalSettingsLevelConfigValidators() []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("company_id"),
+			path.MatchRoot("merchant_id"),
+			path.MatchRoot("store_id"),
+			path.MatchRoot("terminal_id"),
+		),
+	}
+}
+
+// importTerminalSettingsLevel sets the level attribute and identifier of an import identifier of the form "<level>/<id>".
+func importTerminalSettingsLevel(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, id, err := splitImportID(req.ID)
+	attribute, ok := terminalSettingsLevels[name]
+	if err != nil || !ok {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import identifier of the form '<level>/<id>' with level company, merchant, store or terminal, got '%s'.", req.ID),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the level attribute, Read fills in the remaining state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name+"/"+id)...)
+}
+
+// terminalSettingsResourceModel maps the "terminal_settings" schema data for a resource.
+type terminalSettingsResourceModel struct {
+	ID                types.String                            `tfsdk:"id"`
+	CompanyID         types.String                            `tfsdk:"company_id"`
+	MerchantID        types.String                            `tfsdk:"merchant_id"`
+	StoreID           types.String                            `tfsdk:"store_id"`
+	TerminalID        types.String                            `tfsdk:"terminal_id"`
+	ReceiptOptions    *terminalSettingsReceiptOptionsModel    `tfsdk:"receipt_options"`
+	Gratuities        []terminalSettingsGratuityModel         `tfsdk:"gratuities"`
+	Surcharge         *terminalSettingsSurchargeModel         `tfsdk:"surcharge"`
+	WifiProfiles      *terminalSettingsWifiProfilesModel      `tfsdk:"wifi_profiles"`
+	OfflineProcessing *terminalSettingsOfflineProcessingModel `tfsdk:"offline_processing"`
+	Opi               *terminalSettingsOpiModel               `tfsdk:"opi"`
+	Passcodes         *terminalSettingsPasscodesModel         `tfsdk:"passcodes"`
+	Timeouts          *terminalSettingsTimeoutsModel          `tfsdk:"timeouts"`
+	Standalone        *terminalSettingsStandaloneModel        `tfsdk:"standalone"`
+}
+
+// terminalSettingsReceiptOptionsModel maps the receipt options of terminal settings.
+type terminalSettingsReceiptOptionsModel struct {
+	Logo                 types.String `tfsdk:"logo"`
+	PromptBeforePrinting types.Bool   `tfsdk:"prompt_before_printing"`
+	QrCodeData           types.String `tfsdk:"qr_code_data"`
+}
+
+// terminalSettingsGratuityModel maps the tipping settings for one currency of terminal settings.
+type terminalSettingsGratuityModel struct {
+	Currency                types.String `tfsdk:"currency"`
+	AllowCustomAmount       types.Bool   `tfsdk:"allow_custom_amount"`
+	UsePredefinedTipEntries types.Bool   `tfsdk:"use_predefined_tip_entries"`
+	PredefinedTipEntries    []string     `tfsdk:"predefined_tip_entries"`
+}
+
+// terminalSettingsSurchargeModel maps the surcharge settings of terminal settings.
+type terminalSettingsSurchargeModel struct {
+	AskConfirmation types.Bool                                    `tfsdk:"ask_confirmation"`
+	Configurations  []terminalSettingsSurchargeConfigurationModel `tfsdk:"configurations"`
+}
+
+// terminalSettingsSurchargeConfigurationModel maps the surcharge of one card brand.
+type terminalSettingsSurchargeConfigurationModel struct {
+	Brand      types.String                             `tfsdk:"brand"`
+	Countries  []string                                 `tfsdk:"countries"`
+	Sources    []string                                 `tfsdk:"sources"`
+	Currencies []terminalSettingsSurchargeCurrencyModel `tfsdk:"currencies"`
+}
+
+// terminalSettingsSurchargeCurrencyModel maps the surcharge of one card brand in one currency.
+type terminalSettingsSurchargeCurrencyModel struct {
+	CurrencyCode types.String  `tfsdk:"currency_code"`
+	Amount       types.Int64   `tfsdk:"amount"`
+	Percentage   types.Float64 `tfsdk:"percentage"`
+}
+
+// terminalSettingsWifiProfilesModel maps the Wi-Fi settings of terminal settings.
+type terminalSettingsWifiProfilesModel struct {
+	Profiles []terminalSettingsWifiProfileModel `tfsdk:"profiles"`
+	Settings *terminalSettingsWifiSettingsModel `tfsdk:"settings"`
+}
+
+// terminalSettingsWifiProfileModel maps one Wi-Fi network terminals connect to.
+type terminalSettingsWifiProfileModel struct {
+	Name           types.String `tfsdk:"name"`
+	Ssid           types.String `tfsdk:"ssid"`
+	AuthType       types.String `tfsdk:"auth_type"`
+	BssType        types.String `tfsdk:"bss_type"`
+	Wsec           types.String `tfsdk:"wsec"`
+	Psk            types.String `tfsdk:"psk"`
+	Eap            types.String `tfsdk:"eap"`
+	EapIdentity    types.String `tfsdk:"eap_identity"`
+	EapPwd         types.String `tfsdk:"eap_pwd"`
+	Channel        types.Int64  `tfsdk:"channel"`
+	HiddenSsid     types.Bool   `tfsdk:"hidden_ssid"`
+	AutoWifi       types.Bool   `tfsdk:"auto_wifi"`
+	DefaultProfile types.Bool   `tfsdk:"default_profile"`
+}
+
+// terminalSettingsWifiSettingsModel maps the Wi-Fi settings that apply to all profiles.
+type terminalSettingsWifiSettingsModel struct {
+	Band    types.String `tfsdk:"band"`
+	Roaming types.Bool   `tfsdk:"roaming"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+// terminalSettingsOfflineProcessingModel maps the offline processing settings of terminal settings.
+type terminalSettingsOfflineProcessingModel struct {
+	ChipFloorLimit     types.Int64                          `tfsdk:"chip_floor_limit"`
+	OfflineSwipeLimits []terminalSettingsMonetaryValueModel `tfsdk:"offline_swipe_limits"`
+}
+
+// terminalSettingsMonetaryValueModel maps an amount in minor units of a currency.
+type terminalSettingsMonetaryValueModel struct {
+	CurrencyCode types.String `tfsdk:"currency_code"`
+	Amount       types.Int64  `tfsdk:"amount"`
+}
+
+// terminalSettingsOpiModel maps the Oracle Payment Interface settings of terminal settings.
+type terminalSettingsOpiModel struct {
+	EnablePayAtTable      types.Bool   `tfsdk:"enable_pay_at_table"`
+	PayAtTableStoreNumber types.String `tfsdk:"pay_at_table_store_number"`
+	PayAtTableURL         types.String `tfsdk:"pay_at_table_url"`
+}
+
+// terminalSettingsPasscodesModel maps the passcodes of terminal settings.
+type terminalSettingsPasscodesModel struct {
+	AdminMenuPin  types.String `tfsdk:"admin_menu_pin"`
+	RefundPin     types.String `tfsdk:"refund_pin"`
+	ScreenLockPin types.String `tfsdk:"screen_lock_pin"`
+	TxMenuPin     types.String `tfsdk:"tx_menu_pin"`
+}
+
+// terminalSettingsTimeoutsModel maps the timeouts of terminal settings.
+type terminalSettingsTimeoutsModel struct {
+	FromActiveToSleep types.Int64 `tfsdk:"from_active_to_sleep"`
+}
+
+// terminalSettingsStandaloneModel maps the standalone mode settings of terminal settings.
+type terminalSettingsStandaloneModel struct {
+	EnableStandalone types.Bool   `tfsdk:"enable_standalone"`
+	CurrencyCode     types.String `tfsdk:"currency_code"`
+}
+
+// refreshString returns the value Adyen has for a configured attribute. Attributes that are not configured stay null,
+// so values inherited from the level above do not show up as drift. With all set, every attribute is taken from Adyen.
+func refreshString(current types.String, value *string, all bool) types.String {
+	if !all && current.IsNull() {
+		return current
+	}
+	return types.StringPointerValue(value)
+}
+
+// refreshBool returns the value Adyen has for a configured attribute, see refreshString.
+func refreshBool(current types.Bool, value *bool, all bool) types.Bool {
+	if !all && current.IsNull() {
+		return current
+	}
+	return types.BoolPointerValue(value)
+}
+
+// refreshInt64 returns the value Adyen has for a configured attribute, see refreshString.
+func refreshInt64(current types.Int64, value *int32, all bool) types.Int64 {
+	if !all && current.IsNull() {
+		return current
+	}
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
+
+// refreshFloat64 returns the value Adyen has for a configured attribute, see refreshString.
+func refreshFloat64(current types.Float64, value *float64, all bool) types.Float64 {
+	if !all && current.IsNull() {
+		return current
+	}
+	return types.Float64PointerValue(value)
+}
+
+// refreshStrings returns the values Adyen has for a configured list attribute, see refreshString.
+func refreshStrings(current []string, value []string, all bool) []string {
+	if !all && current == nil {
+		return nil
+	}
+	return value
+}
+
+// int32Pointer maps an integer attribute to an Adyen API request, returning nil for a null or unknown value.
+func int32Pointer(value types.Int64) *int32 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	v := int32(value.ValueInt64())
+	return &v
+}
+
+// mapTerminalSettingsRequest maps the configured terminal settings to an Adyen API request. Settings that are not configured
+// are left out of the request, so they keep the value inherited from the level above.
+func mapTerminalSettingsRequest(plan terminalSettingsResourceModel) management.TerminalSettings {
+	var settings management.TerminalSettings
+	if plan.ReceiptOptions != nil {
+		settings.ReceiptOptions = &management.ReceiptOptions{
+			Logo:                 plan.ReceiptOptions.Logo.ValueStringPointer(),
+			PromptBeforePrinting: plan.ReceiptOptions.PromptBeforePrinting.ValueBoolPointer(),
+			QrCodeData:           plan.ReceiptOptions.QrCodeData.ValueStringPointer(),
+		}
+	}
+	if plan.Gratuities != nil {
+		settings.Gratuities = []management.Gratuity{}
+		for _, gratuity := range plan.Gratuities {
+			settings.Gratuities = append(settings.Gratuities, management.Gratuity{
+				Currency:                gratuity.Currency.ValueStringPointer(),
+				AllowCustomAmount:       gratuity.AllowCustomAmount.ValueBoolPointer(),
+				UsePredefinedTipEntries: gratuity.UsePredefinedTipEntries.ValueBoolPointer(),
+				PredefinedTipEntries:    gratuity.PredefinedTipEntries,
+			})
+		}
+	}
+	if plan.Surcharge != nil {
+		settings.Surcharge = &management.Surcharge{AskConfirmation: plan.Surcharge.AskConfirmation.ValueBoolPointer()}
+		for _, configuration := range plan.Surcharge.Configurations {
+			request := management.Configuration{
+				Brand:      configuration.Brand.ValueString(),
+				Country:    configuration.Countries,
+				Sources:    configuration.Sources,
+				Currencies: []management.Currency{},
+			}
+			for _, currency := range configuration.Currencies {
+				request.Currencies = append(request.Currencies, management.Currency{
+					CurrencyCode: currency.CurrencyCode.ValueString(),
+					Amount:       int32Pointer(currency.Amount),
+					Percentage:   currency.Percentage.ValueFloat64Pointer(),
+				})
+			}
+			settings.Surcharge.Configurations = append(settings.Surcharge.Configurations, request)
+		}
+	}
+	if plan.WifiProfiles != nil {
+		settings.WifiProfiles = &management.WifiProfiles{}
+		for _, profile := range plan.WifiProfiles.Profiles {
+			settings.WifiProfiles.Profiles = append(settings.WifiProfiles.Profiles, management.Profile{
+				Name:           profile.Name.ValueStringPointer(),
+				Ssid:           profile.Ssid.ValueString(),
+				AuthType:       profile.AuthType.ValueString(),
+				BssType:        profile.BssType.ValueString(),
+				Wsec:           profile.Wsec.ValueString(),
+				Psk:            profile.Psk.ValueStringPointer(),
+				Eap:            profile.Eap.ValueStringPointer(),
+				EapIdentity:    profile.EapIdentity.ValueStringPointer(),
+				EapPwd:         profile.EapPwd.ValueStringPointer(),
+				Channel:        int32Pointer(profile.Channel),
+				HiddenSsid:     profile.HiddenSsid.ValueBoolPointer(),
+				AutoWifi:       profile.AutoWifi.ValueBoolPointer(),
+				DefaultProfile: profile.DefaultProfile.ValueBoolPointer(),
+			})
+		}
+		if plan.WifiProfiles.Settings != nil {
+			settings.WifiProfiles.Settings = &management.Settings{
+				Band:    plan.WifiProfiles.Settings.Band.ValueStringPointer(),
+				Roaming: plan.WifiProfiles.Settings.Roaming.ValueBoolPointer(),
+				Timeout: int32Pointer(plan.WifiProfiles.Settings.Timeout),
+			}
+		}
+	}
+	if plan.OfflineProcessing != nil {
+		settings.OfflineProcessing = &management.OfflineProcessing{ChipFloorLimit: int32Pointer(plan.OfflineProcessing.ChipFloorLimit)}
+		for _, limit := range plan.OfflineProcessing.OfflineSwipeLimits {
+			settings.OfflineProcessing.OfflineSwipeLimits = append(settings.OfflineProcessing.OfflineSwipeLimits, management.MinorUnitsMonetaryValue{
+				CurrencyCode: limit.CurrencyCode.ValueStringPointer(),
+				Amount:       int32Pointer(limit.Amount),
+			})
+		}
+	}
+	if plan.Opi != nil {
+		settings.Opi = &management.Opi{
+			EnablePayAtTable:      plan.Opi.EnablePayAtTable.ValueBoolPointer(),
+			PayAtTableStoreNumber: plan.Opi.PayAtTableStoreNumber.ValueStringPointer(),
+			PayAtTableURL:         plan.Opi.PayAtTableURL.ValueStringPointer(),
+		}
+	}
+	if plan.Passcodes != nil {
+		settings.Passcodes = &management.Passcodes{
+			AdminMenuPin:  plan.Passcodes.AdminMenuPin.ValueStringPointer(),
+			RefundPin:     plan.Passcodes.RefundPin.ValueStringPointer(),
+			ScreenLockPin: plan.Passcodes.ScreenLockPin.ValueStringPointer(),
+			TxMenuPin:     plan.Passcodes.TxMenuPin.ValueStringPointer(),
+		}
+	}
+	if plan.Timeouts != nil {
+		settings.Timeouts = &management.Timeouts{FromActiveToSleep: int32Pointer(plan.Timeouts.FromActiveToSleep)}
+	}
+	if plan.Standalone != nil {
+		settings.Standalone = &management.Standalone{
+			EnableStandalone: plan.Standalone.EnableStandalone.ValueBoolPointer(),
+			CurrencyCode:     plan.Standalone.CurrencyCode.ValueStringPointer(),
+		}
+	}
+	return settings
+}
+
+// mapTerminalSettingsResourceModel maps terminal settings returned by the Adyen API to the Terraform model. Only the settings
+// that are configured in the current model are refreshed, unless all is set, e.g. right after an import.
+// Adyen does not return passcodes and Wi-Fi passwords, so these keep their configured values.
+func mapTerminalSettingsResourceModel(settings management.TerminalSettings, current terminalSettingsResourceModel, all bool) terminalSettingsResourceModel {
+	model := terminalSettingsResourceModel{
+		ID:         current.ID,
+		CompanyID:  current.CompanyID,
+		MerchantID: current.MerchantID,
+		StoreID:    current.StoreID,
+		TerminalID: current.TerminalID,
+	}
+
+	if current.ReceiptOptions != nil || (all && settings.ReceiptOptions != nil) {
+		api, cur := settings.ReceiptOptions, current.ReceiptOptions
+		if api == nil {
+			api = &management.ReceiptOptions{}
+		}
+		if cur == nil {
+			cur = &terminalSettingsReceiptOptionsModel{}
+		}
+		model.ReceiptOptions = &terminalSettingsReceiptOptionsModel{
+			Logo:                 refreshString(cur.Logo, api.Logo, all),
+			PromptBeforePrinting: refreshBool(cur.PromptBeforePrinting, api.PromptBeforePrinting, all),
+			QrCodeData:           refreshString(cur.QrCodeData, api.QrCodeData, all),
+		}
+	}
+
+	if current.Gratuities != nil || (all && settings.Gratuities != nil) {
+		model.Gratuities = []terminalSettingsGratuityModel{}
+		for i, api := range settings.Gratuities {
+			cur, full := terminalSettingsGratuityModel{}, all || i >= len(current.Gratuities)
+			if !full {
+				cur = current.Gratuities[i]
+			}
+			model.Gratuities = append(model.Gratuities, terminalSettingsGratuityModel{
+				Currency:                refreshString(cur.Currency, api.Currency, full),
+				AllowCustomAmount:       refreshBool(cur.AllowCustomAmount, api.AllowCustomAmount, full),
+				UsePredefinedTipEntries: refreshBool(cur.UsePredefinedTipEntries, api.UsePredefinedTipEntries, full),
+				PredefinedTipEntries:    refreshStrings(cur.PredefinedTipEntries, api.PredefinedTipEntries, full),
+			})
+		}
+	}
+
+	if current.Surcharge != nil || (all && settings.Surcharge != nil) {
+		api, cur := settings.Surcharge, current.Surcharge
+		if api == nil {
+			api = &management.Surcharge{}
+		}
+		if cur == nil {
+			cur = &terminalSettingsSurchargeModel{}
+		}
+		model.Surcharge = &terminalSettingsSurchargeModel{
+			AskConfirmation: refreshBool(cur.AskConfirmation, api.AskConfirmation, all),
+		}
+		if cur.Configurations != nil || (all && api.Configurations != nil) {
+			model.Surcharge.Configurations = []terminalSettingsSurchargeConfigurationModel{}
+			for i, configuration := range api.Configurations {
+				curConfiguration, full := terminalSettingsSurchargeConfigurationModel{}, all || i >= len(cur.Configurations)
+				if !full {
+					curConfiguration = cur.Configurations[i]
+				}
+				mapped := terminalSettingsSurchargeConfigurationModel{
+					Brand:      types.StringValue(configuration.Brand),
+					Countries:  refreshStrings(curConfiguration.Countries, configuration.Country, full),
+					Sources:    refreshStrings(curConfiguration.Sources, configuration.Sources, full),
+					Currencies: []terminalSettingsSurchargeCurrencyModel{},
+				}
+				for j, currency := range configuration.Currencies {
+					curCurrency, fullCurrency := terminalSettingsSurchargeCurrencyModel{}, full || j >= len(curConfiguration.Currencies)
+					if !fullCurrency {
+						curCurrency = curConfiguration.Currencies[j]
+					}
+					mapped.Currencies = append(mapped.Currencies, terminalSettingsSurchargeCurrencyModel{
+						CurrencyCode: types.StringValue(currency.CurrencyCode),
+						Amount:       refreshInt64(curCurrency.Amount, currency.Amount, fullCurrency),
+						Percentage:   refreshFloat64(curCurrency.Percentage, currency.Percentage, fullCurrency),
+					})
+				}
+				model.Surcharge.Configurations = append(model.Surcharge.Configurations, mapped)
+			}
+		}
+	}
+
+	if current.WifiProfiles != nil || (all && settings.WifiProfiles != nil) {
+		api, cur := settings.WifiProfiles, current.WifiProfiles
+		if api == nil {
+			api = &management.WifiProfiles{}
+		}
+		if cur == nil {
+			cur = &terminalSettingsWifiProfilesModel{}
+		}
+		model.WifiProfiles = &terminalSettingsWifiProfilesModel{}
+		if cur.Profiles != nil || (all && api.Profiles != nil) {
+			model.WifiProfiles.Profiles = []terminalSettingsWifiProfileModel{}
+			for i, profile := range api.Profiles {
+				curProfile, full := terminalSettingsWifiProfileModel{Psk: types.StringNull(), EapPwd: types.StringNull()}, all || i >= len(cur.Profiles)
+				if !full {
+					curProfile = cur.Profiles[i]
+				}
+				model.WifiProfiles.Profiles = append(model.WifiProfiles.Profiles, terminalSettingsWifiProfileModel{
+					Name:           refreshString(curProfile.Name, profile.Name, full),
+					Ssid:           types.StringValue(profile.Ssid),
+					AuthType:       types.StringValue(profile.AuthType),
+					BssType:        types.StringValue(profile.BssType),
+					Wsec:           types.StringValue(profile.Wsec),
+					Psk:            curProfile.Psk,
+					Eap:            refreshString(curProfile.Eap, profile.Eap, full),
+					EapIdentity:    refreshString(curProfile.EapIdentity, profile.EapIdentity, full),
+					EapPwd:         curProfile.EapPwd,
+					Channel:        refreshInt64(curProfile.Channel, profile.Channel, full),
+					HiddenSsid:     refreshBool(curProfile.HiddenSsid, profile.HiddenSsid, full),
+					AutoWifi:       refreshBool(curProfile.AutoWifi, profile.AutoWifi, full),
+					DefaultProfile: refreshBool(curProfile.DefaultProfile, profile.DefaultProfile, full),
+				})
+			}
+		}
+		if cur.Settings != nil || (all && api.Settings != nil) {
+			apiSettings, curSettings := api.Settings, cur.Settings
+			if apiSettings == nil {
+				apiSettings = &management.Settings{}
+			}
+			if curSettings == nil {
+				curSettings = &terminalSettingsWifiSettingsModel{}
+			}
+			model.WifiProfiles.Settings = &terminalSettingsWifiSettingsModel{
+				Band:    refreshString(curSettings.Band, apiSettings.Band, all),
+				Roaming: refreshBool(curSettings.Roaming, apiSettings.Roaming, all),
+				Timeout: refreshInt64(curSettings.Timeout, apiSettings.Timeout, all),
+			}
+		}
+	}
+
+	if current.OfflineProcessing != nil || (all && settings.OfflineProcessing != nil) {
+		api, cur := settings.OfflineProcessing, current.OfflineProcessing
+		if api == nil {
+			api = &management.OfflineProcessing{}
+		}
+		if cur == nil {
+			cur = &terminalSettingsOfflineProcessingModel{}
+		}
+		model.OfflineProcessing = &terminalSettingsOfflineProcessingModel{
+			ChipFloorLimit: refreshInt64(cur.ChipFloorLimit, api.ChipFloorLimit, all),
+		}
+		if cur.OfflineSwipeLimits != nil || (all && api.OfflineSwipeLimits != nil) {
+			model.OfflineProcessing.OfflineSwipeLimits = []terminalSettingsMonetaryValueModel{}
+			for i, limit := range api.OfflineSwipeLimits {
+				curLimit, full := terminalSettingsMonetaryValueModel{}, all || i >= len(cur.OfflineSwipeLimits)
+				if !full {
+					curLimit = cur.OfflineSwipeLimits[i]
+				}
+				model.OfflineProcessing.OfflineSwipeLimits = append(model.OfflineProcessing.OfflineSwipeLimits, terminalSettingsMonetaryValueModel{
+					CurrencyCode: refreshString(curLimit.CurrencyCode, limit.CurrencyCode, full),
+					Amount:       refreshInt64(curLimit.Amount, limit.Amount, full),
+				})
+			}
+		}
+	}
+
+	if current.Opi != nil || (all && settings.Opi != nil) {
+		api, cur := settings.Opi, current.Opi
+		if api == nil {
+			api = &management.Opi{}
+		}
+		if cur == nil {
+			cur = &terminalSettingsOpiModel{}
+		}
+		model.Opi = &terminalSettingsOpiModel{
+			EnablePayAtTable:      refreshBool(cur.EnablePayAtTable, api.EnablePayAtTable, all),
+			PayAtTableStoreNumber: refreshString(cur.PayAtTableStoreNumber, api.PayAtTableStoreNumber, all),
+			PayAtTableURL:         refreshString(cur.PayAtTableURL, api.PayAtTableURL, all),
+		}
+	}
+
+	// Adyen does not return passcodes, so they cannot be refreshed or imported.
+	model.Passcodes = current.Passcodes
+
+	if current.Timeouts != nil || (all && settings.Timeouts != nil) {
+		api, cur := settings.Timeouts, current.Timeouts
+		if api == nil {
+			api = &management.Timeouts{}
+		}
+		if cur == nil {
+			cur = &terminalSettingsTimeoutsModel{}
+		}
+		model.Timeouts = &terminalSettingsTimeoutsModel{
+			FromActiveToSleep: refreshInt64(cur.FromActiveToSleep, api.FromActiveToSleep, all),
+		}
+	}
+
+	if current.Standalone != nil || (all && settings.Standalone != nil) {
+		api, cur := settings.Standalone, current.Standalone
+		if api == nil {
+			api = &management.Standalone{}
+		}
+		if cur == nil {
+			cur = &terminalSettingsStandaloneModel{}
+		}
+		model.Standalone = &terminalSettingsStandaloneModel{
+			EnableStandalone: refreshBool(cur.EnableStandalone, api.EnableStandalone, all),
+			CurrencyCode:     refreshString(cur.CurrencyCode, api.CurrencyCode, all),
+		}
+	}
+
+	return model
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *terminalSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *terminalSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terminal_settings"
+}
+
+// ConfigValidators returns the validations that span multiple attributes of the terminal settings.
+func (r *terminalSettingsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return terminalSettingsLevelConfigValidators()
+}
+
+// Schema defines the schema for the resource.
+func (r *terminalSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := terminalSettingsLevelAttributes("terminal settings")
+	attributes["receipt_options"] = schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Receipt options of the terminals.",
+		Attributes: map[string]schema.Attribute{
+			"logo": schema.StringAttribute{
+				Optional:    true,
+				Description: "The receipt logo, base64-encoded. Maximum size 256 KB.",
+			},
+			"prompt_before_printing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Indicates whether the terminal asks before printing the shopper receipt.",
+			},
+			"qr_code_data": schema.StringAttribute{
+				Optional:    true,
+				Description: "Data to print on the receipt as a QR code. Can contain static text and variables such as ${merchantreference}.",
+			},
+		},
+	}
+	attributes["gratuities"] = schema.ListNestedAttribute{
+		Optional:    true,
+		Description: "Tipping settings, per currency.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"currency": schema.StringAttribute{
+					Optional:    true,
+					Description: "The currency the tipping settings apply to, e.g. EUR.",
+				},
+				"allow_custom_amount": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Indicates whether shoppers can enter a tip amount of their own.",
+				},
+				"use_predefined_tip_entries": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Indicates whether the terminal shows the predefined tip entries.",
+				},
+				"predefined_tip_entries": schema.ListAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "Up to four predefined tip options, as percentages (e.g. 5%) or amounts in minor units (e.g. 100).",
+				},
+			},
+		},
+	}
+	attributes["surcharge"] = schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Surcharge settings.",
+		Attributes: map[string]schema.Attribute{
+			"ask_confirmation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Indicates whether the shopper must confirm the surcharge before paying.",
+			},
+			"configurations": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The surcharge per card brand.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"brand": schema.StringAttribute{
+							Required:    true,
+							Description: "The card brand the surcharge applies to, e.g. visa or mc.",
+						},
+						"countries": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The countries of the card issuer the surcharge applies to.",
+						},
+						"sources": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The card funding sources the surcharge applies to, e.g. CREDIT or DEBIT.",
+						},
+						"currencies": schema.ListNestedAttribute{
+							Required:    true,
+							Description: "The surcharge per currency.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"currency_code": schema.StringAttribute{
+										Required:    true,
+										Description: "The three-character ISO currency code, e.g. EUR.",
+									},
+									"amount": schema.Int64Attribute{
+										Optional:    true,
+										Description: "The surcharge amount per transaction, in minor units.",
+									},
+									"percentage": schema.Float64Attribute{
+										Optional:    true,
+										Description: "The surcharge percentage per transaction, with at most two decimals.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	attributes["wifi_profiles"] = schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "The Wi-Fi networks the terminals connect to.",
+		Attributes: map[string]schema.Attribute{
+			"profiles": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The Wi-Fi profiles.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Description: "The name of the profile.",
+						},
+						"ssid": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the Wi-Fi network.",
+						},
+						"auth_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of Wi-Fi network: wpa-psk, wpa2-psk, wpa-eap or wpa2-eap.",
+						},
+						"bss_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of BSS: infra or adhoc.",
+						},
+						"wsec": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of encryption: auto, ccmp (AES) or tkip.",
+						},
+						"psk": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The password of a PSK network. Adyen does not return it, so changes made outside of Terraform are not detected.",
+						},
+						"eap": schema.StringAttribute{
+							Optional:    true,
+							Description: "The EAP method of an EAP network: tls, peap or leap.",
+						},
+						"eap_identity": schema.StringAttribute{
+							Optional:    true,
+							Description: "The username of an EAP network.",
+						},
+						"eap_pwd": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The password of an EAP network. Adyen does not return it, so changes made outside of Terraform are not detected.",
+						},
+						"channel": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The channel number of the Wi-Fi network.",
+						},
+						"hidden_ssid": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Indicates whether the network does not broadcast its name.",
+						},
+						"auto_wifi": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Indicates whether to automatically select the best authentication method.",
+						},
+						"default_profile": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Indicates whether this is the default profile.",
+						},
+					},
+				},
+			},
+			"settings": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Wi-Fi settings that apply to all profiles.",
+				Attributes: map[string]schema.Attribute{
+					"band": schema.StringAttribute{
+						Optional:    true,
+						Description: "The frequency band: 2.4GHz, 5GHz or All.",
+					},
+					"roaming": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Indicates whether roaming is enabled.",
+					},
+					"timeout": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The connection timeout, in seconds.",
+					},
+				},
+			},
+		},
+	}
+	attributes["offline_processing"] = schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Offline processing settings.",
+		Attributes: map[string]schema.Attribute{
+			"chip_floor_limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum amount, in minor units, of chip transactions that can be processed offline.",
+			},
+			"offline_swipe_limits": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The maximum amounts of swiped transactions that can be processed offline, per currency.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"currency_code": schema.StringAttribute{
+							Optional:    true,
+							Description: "The three-character ISO currency code, e.g. EUR.",
+						},
+						"amount": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The amount, in minor units.",
+						},
+					},
+				},
+			},
+		},
+	}
+	attributes["opi"] = schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Oracle Payment Interface (OPI) settings, for pay at table.",
+		Attributes: map[string]schema.Attribute{
+			"enable_pay_at_table": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Indicates whether pay at table is enabled.",
+			},
+			"pay_at_table_store_number": schema.StringAttribute{
+				Optional:    true,
+				Description: "The store number to use for pay at table.",
+			},
+			"pay_at_table_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL and port number used for pay at table communication.",
+			},
+		},
+	}
+	attributes["passcodes"] = schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Passcodes of the terminal menus. Adyen does not return passcodes, so changes made outside of Terraform are not detected.",
+		Attributes: map[string]schema.Attribute{
+			"admin_menu_pin": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The passcode of the admin menu.",
+			},
+			"refund_pin": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The passcode for referenced and unreferenced refunds.",
+			},
+			"screen_lock_pin": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The passcode to unlock the terminal screen after a timeout.",
+			},
+			"tx_menu_pin": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The passcode of the transactions menu.",
+			},
+		},
+	}
+	attributes["timeouts"] = schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Timeouts of the terminals.",
+		Attributes: map[string]schema.Attribute{
+			"from_active_to_sleep": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of seconds before the terminal goes to sleep.",
+			},
+		},
+	}
+	attributes["standalone"] = schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Standalone mode settings, for terminals that are not integrated with a cash register.",
+		Attributes: map[string]schema.Attribute{
+			"enable_standalone": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Indicates whether standalone mode is enabled.",
+			},
+			"currency_code": schema.StringAttribute{
+				Optional:    true,
+				Description: "The default currency of the standalone terminal, e.g. EUR.",
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the payment terminal settings of a company account, merchant account, store or single terminal. " +
+			"Only the configured settings are changed and refreshed; all other settings keep what they inherit from the level above.\n\n" +
+			"Adyen does not allow resetting settings to what they inherit, so removing a setting from the configuration, or destroying this resource, " +
+			"leaves its last value in place.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Terminal settings read and write",
+		Attributes: attributes,
+	}
+}
+
+// Create applies the terminal settings and sets the initial Terraform state.
+func (r *terminalSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen terminal settings")
+
+	// Retrieve values from the plan
+	var plan terminalSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	level := newTerminalSettingsLevel(plan.CompanyID, plan.MerchantID, plan.StoreID, plan.TerminalID)
+	settings, _, err := level.updateSettings(ctx, r.client, mapTerminalSettingsRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating terminal settings",
+			"Could not update terminal settings of "+level.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(level.String())
+	plan = mapTerminalSettingsResourceModel(settings, plan, false)
+
+	// Set state with the applied terminal settings
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *terminalSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state terminalSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imported, diags := req.Private.GetKey(ctx, terminalSettingsImportedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	level := newTerminalSettingsLevel(state.CompanyID, state.MerchantID, state.StoreID, state.TerminalID)
+	settings, httpRes, err := level.getSettings(ctx, r.client)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the account, store or terminal does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Terminal Settings",
+			"Could not read terminal settings of "+level.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = mapTerminalSettingsResourceModel(settings, state, imported != nil)
+
+	tflog.Debug(ctx, "Reading terminal settings...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, terminalSettingsImportedKey, nil)...)
+}
+
+// Update applies the changed terminal settings and sets the updated Terraform state on success.
+func (r *terminalSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating adyen terminal settings")
+
+	// Retrieve values from the plan
+	var plan terminalSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	level := newTerminalSettingsLevel(plan.CompanyID, plan.MerchantID, plan.StoreID, plan.TerminalID)
+	settings, _, err := level.updateSettings(ctx, r.client, mapTerminalSettingsRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating terminal settings",
+			"Could not update terminal settings of "+level.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan = mapTerminalSettingsResourceModel(settings, plan, false)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the terminal settings from the Terraform state. Adyen cannot reset settings to what they inherit, so they keep their last values.
+func (r *terminalSettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing terminal settings from state")
+}
+
+// ImportState imports the terminal settings of a level using an identifier of the form "<level>/<id>", e.g. "merchant/WeaveAccountECOM".
+// All settings of the level are imported, including the ones it inherits.
+func (r *terminalSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importTerminalSettingsLevel(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, terminalSettingsImportedKey, []byte("true"))...)
+}
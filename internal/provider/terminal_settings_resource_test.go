@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccTerminalSettingsResource(t *testing.T) {
+	merchantSettings := "adyen_terminal_settings.merchant"
+	storeSettings := "adyen_terminal_settings.store"
+	var storeID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testProviderClientFromTmpl(t) + testConfigTerminalSettingsLevels(),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalSettings("1234", 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(merchantSettings, "id", "merchant/WeaveAccountECOM"),
+					resource.TestCheckResourceAttr(merchantSettings, "receipt_options.prompt_before_printing", "true"),
+					resource.TestCheckNoResourceAttr(merchantSettings, "receipt_options.logo"),
+					resource.TestCheckResourceAttr(merchantSettings, "gratuities.0.currency", "EUR"),
+					resource.TestCheckResourceAttr(merchantSettings, "gratuities.0.predefined_tip_entries.#", "3"),
+					resource.TestCheckResourceAttr(merchantSettings, "surcharge.configurations.0.brand", "mc"),
+					resource.TestCheckResourceAttr(merchantSettings, "surcharge.configurations.0.currencies.0.percentage", "1.5"),
+					resource.TestCheckResourceAttr(merchantSettings, "wifi_profiles.profiles.0.ssid", "Terraform"),
+					resource.TestCheckResourceAttr(merchantSettings, "offline_processing.offline_swipe_limits.0.amount", "5000"),
+					resource.TestCheckResourceAttr(merchantSettings, "passcodes.admin_menu_pin", "1234"),
+					resource.TestCheckResourceAttr(merchantSettings, "standalone.enable_standalone", "false"),
+					resource.TestCheckResourceAttrPair(storeSettings, "store_id", "adyen_store.terminal_settings", "id"),
+					resource.TestCheckResourceAttr(storeSettings, "timeouts.from_active_to_sleep", "60"),
+					resource.TestCheckNoResourceAttr(storeSettings, "opi"),
+					func(s *terraform.State) error {
+						storeID = s.RootModule().Resources[storeSettings].Primary.Attributes["store_id"]
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      merchantSettings,
+				ImportState:       true,
+				ImportStateId:     "merchant/WeaveAccountECOM",
+				ImportStateVerify: true,
+				// Adyen does not return passcodes and Wi-Fi passwords.
+				ImportStateVerifyIgnore: []string{"passcodes", "wifi_profiles.profiles.0.psk"},
+			},
+			{
+				ResourceName:      storeSettings,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The import includes the settings the store inherits from the merchant account.
+				ImportStateVerifyIgnore: []string{"receipt_options", "gratuities", "surcharge", "wifi_profiles", "offline_processing", "passcodes", "standalone"},
+			},
+			{
+				PreConfig: func() {
+					updateMerchantTerminalSettings(t, "WeaveAccountECOM", management.TerminalSettings{
+						Localization: &management.Localization{Language: common.PtrString("nl")},
+					})
+				},
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalSettings("4321", 120),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(merchantSettings, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(storeSettings, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(merchantSettings, "passcodes.admin_menu_pin", "4321"),
+					resource.TestCheckResourceAttr(merchantSettings, "gratuities.0.currency", "EUR"),
+					resource.TestCheckResourceAttr(storeSettings, "timeouts.from_active_to_sleep", "120"),
+				),
+			},
+			{
+				// Settings the store inherits from the merchant account do not show up as drift, and updating the store
+				// does not copy them to the store, so it keeps following the merchant account.
+				PreConfig: func() {
+					updateMerchantTerminalSettings(t, "WeaveAccountECOM", management.TerminalSettings{
+						Localization: &management.Localization{Language: common.PtrString("de")},
+					})
+				},
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalSettings("4321", 120),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(storeSettings, "receipt_options"),
+					resource.TestCheckNoResourceAttr(storeSettings, "gratuities"),
+					func(s *terraform.State) error {
+						settings := getStoreTerminalSettings(t, storeID)
+						if settings.Localization.GetLanguage() != "de" {
+							return fmt.Errorf("expected store %s to inherit language de, got %q", storeID, settings.Localization.GetLanguage())
+						}
+						if !settings.ReceiptOptions.GetPromptBeforePrinting() {
+							return fmt.Errorf("expected store %s to inherit the receipt options of the merchant account", storeID)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Settings that are not managed by the resource can change without causing drift.
+				PreConfig: func() {
+					updateStoreTerminalSettings(t, storeID, management.TerminalSettings{
+						Opi: &management.Opi{EnablePayAtTable: common.PtrBool(true)},
+					})
+				},
+				Config:   testProviderClientFromTmpl(t) + testConfigTerminalSettings("4321", 120),
+				PlanOnly: true,
+			},
+			{
+				// Changing a managed setting outside of Terraform must show up as drift.
+				PreConfig: func() {
+					updateStoreTerminalSettings(t, storeID, management.TerminalSettings{
+						Timeouts: &management.Timeouts{FromActiveToSleep: common.PtrInt32(30)},
+					})
+				},
+				Config:             testProviderClientFromTmpl(t) + testConfigTerminalSettings("4321", 120),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func updateStoreTerminalSettings(t *testing.T, storeID string, settings management.TerminalSettings) {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	data := client.Management().TerminalSettingsStoreLevelApi.UpdateTerminalSettingsByStoreIdInput(storeID).TerminalSettings(settings)
+	if _, _, err := client.Management().TerminalSettingsStoreLevelApi.UpdateTerminalSettingsByStoreId(context.Background(), data); err != nil {
+		t.Fatalf("could not update terminal settings of store %s: %s", storeID, err)
+	}
+}
+
+func updateMerchantTerminalSettings(t *testing.T, merchantID string, settings management.TerminalSettings) {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	data := client.Management().TerminalSettingsMerchantLevelApi.UpdateTerminalSettingsInput(merchantID).TerminalSettings(settings)
+	if _, _, err := client.Management().TerminalSettingsMerchantLevelApi.UpdateTerminalSettings(context.Background(), data); err != nil {
+		t.Fatalf("could not update terminal settings of merchant account %s: %s", merchantID, err)
+	}
+}
+
+func getStoreTerminalSettings(t *testing.T, storeID string) management.TerminalSettings {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	data := client.Management().TerminalSettingsStoreLevelApi.GetTerminalSettingsByStoreIdInput(storeID)
+	settings, _, err := client.Management().TerminalSettingsStoreLevelApi.GetTerminalSettingsByStoreId(context.Background(), data)
+	if err != nil {
+		t.Fatalf("could not read terminal settings of store %s: %s", storeID, err)
+	}
+	return settings
+}
+
+func testConfigTerminalSettingsLevels() string {
+	return `
+	resource "adyen_terminal_settings" "invalid" {
+		company_id  = "WeaveAccount"
+		merchant_id = "WeaveAccountECOM"
+	}
+`
+}
+
+func testConfigTerminalSettings(adminMenuPin string, fromActiveToSleep int) string {
+	return fmt.Sprintf(`
+	resource "adyen_terminal_settings" "merchant" {
+		merchant_id = "WeaveAccountECOM"
+
+		receipt_options = {
+			prompt_before_printing = true
+			qr_code_data           = "http://www.example.com/order/$${merchantreference}"
+		}
+
+		gratuities = [
+			{
+				currency                   = "EUR"
+				use_predefined_tip_entries = true
+				predefined_tip_entries     = ["5%%", "10%%", "15%%"]
+			},
+		]
+
+		surcharge = {
+			ask_confirmation = true
+			configurations = [
+				{
+					brand = "mc"
+					currencies = [
+						{
+							currency_code = "EUR"
+							percentage    = 1.5
+						},
+					]
+				},
+			]
+		}
+
+		wifi_profiles = {
+			profiles = [
+				{
+					name      = "Terraform"
+					ssid      = "Terraform"
+					auth_type = "wpa2-psk"
+					bss_type  = "infra"
+					wsec      = "ccmp"
+					psk       = "terraform-secret"
+				},
+			]
+		}
+
+		offline_processing = {
+			chip_floor_limit = 0
+			offline_swipe_limits = [
+				{
+					currency_code = "EUR"
+					amount        = 5000
+				},
+			]
+		}
+
+		passcodes = {
+			admin_menu_pin = "%s"
+		}
+
+		standalone = {
+			enable_standalone = false
+		}
+	}
+
+	resource "adyen_store" "terminal_settings" {
+		reference         = "TerraformTerminalSettings"
+		description       = "Terraform terminal settings store"
+		shopper_statement = "Terraform Terminal"
+		phone_number      = "+31201234567"
+		address = {
+			country = "NL"
+			city    = "Amsterdam"
+		}
+	}
+
+	resource "adyen_terminal_settings" "store" {
+		store_id = adyen_store.terminal_settings.id
+
+		timeouts = {
+			from_active_to_sleep = %d
+		}
+	}
+`, adminMenuPin, fromActiveToSleep)
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &terminalsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &terminalsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &terminalsDataSource{}
+)
+
+// terminalsDataSource is the data source implementation.
+type terminalsDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewTerminalsDataSource is a helper function to simplify the provider implementation.
+func NewTerminalsDataSource() datasource.DataSource {
+	return &terminalsDataSource{}
+}
+
+// terminalsDataSourceModel maps the "terminals" schema data for a data source.
+type terminalsDataSourceModel struct {
+	SearchQuery types.String    `tfsdk:"search_query"`
+	Countries   types.Set       `tfsdk:"countries"`
+	MerchantIDs types.Set       `tfsdk:"merchant_ids"`
+	StoreIDs    types.Set       `tfsdk:"store_ids"`
+	BrandModels types.Set       `tfsdk:"brand_models"`
+	IDs         []string        `tfsdk:"ids"`
+	Terminals   []terminalModel `tfsdk:"terminals"`
+}
+
+// terminalModel maps a payment terminal and where it is assigned.
+type terminalModel struct {
+	ID                types.String `tfsdk:"id"`
+	Model             types.String `tfsdk:"model"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	FirmwareVersion   types.String `tfsdk:"firmware_version"`
+	CompanyID         types.String `tfsdk:"company_id"`
+	MerchantID        types.String `tfsdk:"merchant_id"`
+	StoreID           types.String `tfsdk:"store_id"`
+	Status            types.String `tfsdk:"status"`
+	LastActivityAt    types.String `tfsdk:"last_activity_at"`
+	LastTransactionAt types.String `tfsdk:"last_transaction_at"`
+}
+
+// mapTerminalModel maps a payment terminal returned by the Adyen API to the Terraform model.
+func mapTerminalModel(terminal management.Terminal) terminalModel {
+	model := terminalModel{
+		ID:                types.StringPointerValue(terminal.Id),
+		Model:             types.StringPointerValue(terminal.Model),
+		SerialNumber:      types.StringPointerValue(terminal.SerialNumber),
+		FirmwareVersion:   types.StringPointerValue(terminal.FirmwareVersion),
+		CompanyID:         types.StringNull(),
+		MerchantID:        types.StringNull(),
+		StoreID:           types.StringNull(),
+		Status:            types.StringNull(),
+		LastActivityAt:    types.StringNull(),
+		LastTransactionAt: types.StringNull(),
+	}
+	if terminal.Assignment != nil {
+		model.CompanyID = types.StringValue(terminal.Assignment.CompanyId)
+		model.MerchantID = types.StringPointerValue(terminal.Assignment.MerchantId)
+		model.StoreID = types.StringPointerValue(terminal.Assignment.StoreId)
+		model.Status = types.StringValue(terminal.Assignment.Status)
+	}
+	if terminal.LastActivityAt != nil {
+		model.LastActivityAt = types.StringValue(terminal.LastActivityAt.Format(time.RFC3339))
+	}
+	if terminal.LastTransactionAt != nil {
+		model.LastTransactionAt = types.StringValue(terminal.LastTransactionAt.Format(time.RFC3339))
+	}
+	return model
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *terminalsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *terminalsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terminals"
+}
+
+// ConfigValidators returns the validations that span multiple attributes of the data source.
+// Adyen ignores all other filters when searching terminals by ID, so they cannot be combined.
+func (d *terminalsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	validators := []datasource.ConfigValidator{}
+	for _, filter := range []string{"countries", "merchant_ids", "store_ids", "brand_models"} {
+		validators = append(validators, datasourcevalidator.Conflicting(path.MatchRoot("search_query"), path.MatchRoot(filter)))
+	}
+	return validators
+}
+
+// Schema defines the schema for the data source.
+func (d *terminalsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the payment terminals the API credential has access to, optionally filtered by country, merchant account, store and brand model, or searched by terminal ID. " +
+			"Each terminal shows the company account, merchant account and store it is assigned to.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Terminal actions read",
+		Attributes: map[string]schema.Attribute{
+			"search_query": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return terminals with an ID that contains this text, e.g. V400m. Cannot be combined with the other filters.",
+			},
+			"countries": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return terminals located in these countries, specified by their two-letter country code.",
+			},
+			"merchant_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return terminals assigned to these merchant accounts.",
+			},
+			"store_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return terminals assigned to these stores.",
+			},
+			"brand_models": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return terminals of these models, in the format brand.model, e.g. Verifone.V400m.",
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The unique identifiers of the matching terminals.",
+			},
+			"terminals": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching terminals.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the terminal, e.g. V400m-080020970.",
+						},
+						"model": schema.StringAttribute{
+							Computed:    true,
+							Description: "The model of the terminal.",
+						},
+						"serial_number": schema.StringAttribute{
+							Computed:    true,
+							Description: "The serial number of the terminal.",
+						},
+						"firmware_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The software release currently in use on the terminal.",
+						},
+						"company_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the company account the terminal is assigned to.",
+						},
+						"merchant_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the merchant account the terminal is assigned to, if any.",
+						},
+						"store_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the store the terminal is assigned to, if any.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the assignment: inventory, boarded, deployed or reassignmentInProgress.",
+						},
+						"last_activity_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time of the last activity on the terminal.",
+						},
+						"last_transaction_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time of the last transaction on the terminal.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *terminalsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading terminals data source...")
+
+	var state terminalsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listTerminalsInput := d.client.Management().TerminalsTerminalLevelApi.ListTerminalsInput()
+	if !state.SearchQuery.IsNull() {
+		listTerminalsInput = listTerminalsInput.SearchQuery(state.SearchQuery.ValueString())
+	}
+	countries, diags := mapSetToStrings(ctx, state.Countries)
+	resp.Diagnostics.Append(diags...)
+	merchantIDs, diags := mapSetToStrings(ctx, state.MerchantIDs)
+	resp.Diagnostics.Append(diags...)
+	storeIDs, diags := mapSetToStrings(ctx, state.StoreIDs)
+	resp.Diagnostics.Append(diags...)
+	brandModels, diags := mapSetToStrings(ctx, state.BrandModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adyen expects the values of a filter separated by commas.
+	if len(countries) > 0 {
+		listTerminalsInput = listTerminalsInput.Countries(strings.Join(countries, ","))
+	}
+	if len(merchantIDs) > 0 {
+		listTerminalsInput = listTerminalsInput.MerchantIds(strings.Join(merchantIDs, ","))
+	}
+	if len(storeIDs) > 0 {
+		listTerminalsInput = listTerminalsInput.StoreIds(strings.Join(storeIDs, ","))
+	}
+	if len(brandModels) > 0 {
+		listTerminalsInput = listTerminalsInput.BrandModels(strings.Join(brandModels, ","))
+	}
+
+	terminals, err := listTerminals(ctx, d.client, listTerminalsInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Terminals",
+			"Could not list terminals, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.IDs = []string{}
+	state.Terminals = []terminalModel{}
+	for _, terminal := range terminals {
+		state.IDs = append(state.IDs, terminal.GetId())
+		state.Terminals = append(state.Terminals, mapTerminalModel(terminal))
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listTerminals fetches all pages of terminals matching the filters of the given request.
+func listTerminals(ctx context.Context, client *adyen.APIClient, input management.TerminalsTerminalLevelApiListTerminalsInput) ([]management.Terminal, error) {
+	terminals := []management.Terminal{}
+	for page := int32(1); ; page++ {
+		listTerminalsResponse, _, err := client.Management().TerminalsTerminalLevelApi.ListTerminals(ctx, input.PageNumber(page).PageSize(listPageSize))
+		if err != nil {
+			return nil, err
+		}
+		terminals = append(terminals, listTerminalsResponse.Data...)
+
+		if page >= listTerminalsResponse.PagesTotal {
+			return terminals, nil
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccTerminalsDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + `
+	data "adyen_terminals" "invalid" {
+		search_query = "V400m"
+		merchant_ids = ["WeaveAccountPOS"]
+	}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigTerminalsDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.adyen_terminals.search", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.search", "ids.0", "V400m-080020970"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.search", "ids.1", "V400m-080020971"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.merchant", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.merchant", "terminals.0.id", "V400m-080020970"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.merchant", "terminals.0.model", "V400m"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.merchant", "terminals.0.serial_number", "080-020-970"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.merchant", "terminals.0.company_id", "WeaveAccount"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.merchant", "terminals.0.status", "boarded"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.brand_model", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.store", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.store", "terminals.0.id", "AMS1-000168223606144"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.store", "terminals.0.merchant_id", "WeaveAccountECOM"),
+					resource.TestCheckResourceAttrPair("data.adyen_terminals.store", "terminals.0.store_id", "adyen_store.terminals", "id"),
+					resource.TestCheckResourceAttr("data.adyen_terminals.other_country", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testConfigTerminalsDataSource = `
+	resource "adyen_store" "terminals" {
+		reference         = "TerraformTerminalsStore"
+		description       = "Terraform terminals store"
+		shopper_statement = "Terraform Terminals"
+		phone_number      = "+31201234567"
+		address = {
+			country     = "NL"
+			line1       = "Rokin 49"
+			city        = "Amsterdam"
+			postal_code = "1012 KK"
+		}
+	}
+
+	resource "adyen_terminal_assignment" "terminals" {
+		terminal_id = "AMS1-000168223606144"
+		store_id    = adyen_store.terminals.id
+	}
+
+	data "adyen_terminals" "search" {
+		search_query = "V400m-0800209"
+	}
+
+	data "adyen_terminals" "merchant" {
+		merchant_ids = ["WeaveAccountPOS"]
+	}
+
+	data "adyen_terminals" "brand_model" {
+		brand_models = ["Verifone.V400m"]
+	}
+
+	data "adyen_terminals" "store" {
+		countries = ["NL"]
+		store_ids = [adyen_terminal_assignment.terminals.store_id]
+	}
+
+	data "adyen_terminals" "other_country" {
+		countries = ["BE"]
+		store_ids = [adyen_terminal_assignment.terminals.store_id]
+	}
+`
@@ -8,11 +8,22 @@ import (
 )
 
 func testProviderClientFromTmpl(t *testing.T) string {
+	return testProviderClientWithRequiredRolesFromTmpl(t, "")
+}
+
+// testProviderClientWithRequiredRolesFromTmpl renders the provider configuration with required_roles set to the given HCL list, if not empty.
+func testProviderClientWithRequiredRolesFromTmpl(t *testing.T, requiredRoles string) string {
 	tmplString := `
 	provider "adyen" {
 		api_key = "{{.ApiKey}}"
 		environment = "{{.Environment}}"
 		merchant_account = "{{.MerchantAccount}}"
+		{{- if .BaseURL}}
+		base_url = "{{.BaseURL}}"
+		{{- end}}
+		{{- if .RequiredRoles}}
+		required_roles = {{.RequiredRoles}}
+		{{- end}}
 	}
 
 	`
@@ -25,6 +36,8 @@ func testProviderClientFromTmpl(t *testing.T) string {
 		"ApiKey":          os.Getenv("ADYEN_API_KEY"),
 		"Environment":     os.Getenv("ADYEN_API_ENVIRONMENT"),
 		"MerchantAccount": os.Getenv("ADYEN_API_MERCHANT_ACCOUNT"),
+		"BaseURL":         os.Getenv("ADYEN_API_BASE_URL"),
+		"RequiredRoles":   requiredRoles,
 	}
 
 	var renderedConfig bytes.Buffer
@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userCompanyResource{}
+	_ resource.ResourceWithConfigure   = &userCompanyResource{}
+	_ resource.ResourceWithImportState = &userCompanyResource{}
+)
+
+// userCompanyResource is the resource implementation.
+type userCompanyResource struct {
+	client *adyen.APIClient
+}
+
+// NewUserCompanyResource is a helper function to simplify the provider implementation.
+func NewUserCompanyResource() resource.Resource {
+	return &userCompanyResource{}
+}
+
+// userCompanyResourceModel maps the "user_company" schema data for a resource.
+type userCompanyResourceModel struct {
+	CompanyAccount             types.String   `tfsdk:"company_account"`
+	ID                         types.String   `tfsdk:"id"`
+	Username                   types.String   `tfsdk:"username"`
+	Email                      types.String   `tfsdk:"email"`
+	Name                       *userNameModel `tfsdk:"name"`
+	TimeZoneCode               types.String   `tfsdk:"time_zone_code"`
+	Roles                      types.Set      `tfsdk:"roles"`
+	AccountGroups              types.Set      `tfsdk:"account_groups"`
+	AssociatedMerchantAccounts types.Set      `tfsdk:"associated_merchant_accounts"`
+	Apps                       types.Set      `tfsdk:"apps"`
+	Active                     types.Bool     `tfsdk:"active"`
+}
+
+// mapUserCompanyModel maps a company user returned by the Adyen API to its Terraform model.
+func mapUserCompanyModel(companyAccount string, user management.CompanyUser) userCompanyResourceModel {
+	return userCompanyResourceModel{
+		CompanyAccount:             types.StringValue(companyAccount),
+		ID:                         types.StringValue(user.Id),
+		Username:                   types.StringValue(user.Username),
+		Email:                      types.StringValue(user.Email),
+		Name:                       mapUserNameModel(user.Name),
+		TimeZoneCode:               types.StringValue(user.TimeZoneCode),
+		Roles:                      mapStringsToSet(user.Roles),
+		AccountGroups:              mapStringsToSet(user.AccountGroups),
+		AssociatedMerchantAccounts: mapStringsToSet(user.AssociatedMerchantAccounts),
+		Apps:                       mapStringsToSet(user.Apps),
+		Active:                     types.BoolPointerValue(user.Active),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userCompanyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *userCompanyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_company"
+}
+
+// Schema defines the schema for the resource.
+func (r *userCompanyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := userResourceAttributes()
+	attributes["company_account"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The company account of your Adyen Dashboard Environment. Changing it forces a new user to be created.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["associated_merchant_accounts"] = schema.SetAttribute{
+		Optional:    true,
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "The list of merchant accounts associated with this user.",
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Customer Area user of a company account.\n\n" +
+			"Adyen does not allow deleting users, so destroying this resource deactivates the user instead.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Users read and write",
+		Attributes: attributes,
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userCompanyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen company user")
+
+	// Retrieve values from the plan
+	var plan userCompanyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, diags := mapSetToStrings(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	accountGroups, diags := mapSetToStrings(ctx, plan.AccountGroups)
+	resp.Diagnostics.Append(diags...)
+	associatedMerchantAccounts, diags := mapSetToStrings(ctx, plan.AssociatedMerchantAccounts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	createCompanyUserRequest := management.CreateCompanyUserRequest{
+		Username: plan.Username.ValueString(),
+		Email:    plan.Email.ValueString(),
+		Name: management.Name{
+			FirstName: plan.Name.FirstName.ValueString(),
+			LastName:  plan.Name.LastName.ValueString(),
+		},
+		TimeZoneCode:               knownStringPointer(plan.TimeZoneCode),
+		Roles:                      roles,
+		AccountGroups:              accountGroups,
+		AssociatedMerchantAccounts: associatedMerchantAccounts,
+	}
+
+	companyAccount := plan.CompanyAccount.ValueString()
+	createUserInput := r.client.Management().UsersCompanyLevelApi.
+		CreateNewUserInput(companyAccount).
+		CreateCompanyUserRequest(createCompanyUserRequest)
+	createUserResponse, _, err := r.client.Management().UsersCompanyLevelApi.CreateNewUser(ctx, createUserInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating company user",
+			"Could not create company user "+plan.Username.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	user := management.CompanyUser(createUserResponse)
+
+	// Users are always created as active, so deactivate the user right away if requested.
+	if !plan.Active.IsUnknown() && plan.Active.ValueBool() != user.GetActive() {
+		updateUserInput := r.client.Management().UsersCompanyLevelApi.
+			UpdateUserDetailsInput(companyAccount, user.Id).
+			UpdateCompanyUserRequest(management.UpdateCompanyUserRequest{Active: plan.Active.ValueBoolPointer()})
+		user, _, err = r.client.Management().UsersCompanyLevelApi.UpdateUserDetails(ctx, updateUserInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating company user",
+				"Could not set the active flag of company user "+createUserResponse.Id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate with attribute values
+	plan = mapUserCompanyModel(companyAccount, user)
+
+	// Set state with the fully populated user
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userCompanyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userCompanyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	companyAccount := state.CompanyAccount.ValueString()
+	getUserInput := r.client.Management().UsersCompanyLevelApi.GetUserDetailsInput(companyAccount, state.ID.ValueString())
+	user, httpRes, err := r.client.Management().UsersCompanyLevelApi.GetUserDetails(ctx, getUserInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the user does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User Company",
+			"Could not read company user "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = mapUserCompanyModel(companyAccount, user)
+
+	tflog.Debug(ctx, "Reading company user...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userCompanyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating adyen company user")
+
+	// Retrieve values from the plan
+	var plan userCompanyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An empty set is sent as an empty list, which clears the values in Adyen. A set that is not configured is left out of the request.
+	roles, diags := mapSetToStrings(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	accountGroups, diags := mapSetToStrings(ctx, plan.AccountGroups)
+	resp.Diagnostics.Append(diags...)
+	associatedMerchantAccounts, diags := mapSetToStrings(ctx, plan.AssociatedMerchantAccounts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updateCompanyUserRequest := management.UpdateCompanyUserRequest{
+		Email: plan.Email.ValueStringPointer(),
+		Name: &management.Name2{
+			FirstName: plan.Name.FirstName.ValueStringPointer(),
+			LastName:  plan.Name.LastName.ValueStringPointer(),
+		},
+		TimeZoneCode:               knownStringPointer(plan.TimeZoneCode),
+		Roles:                      roles,
+		AccountGroups:              accountGroups,
+		AssociatedMerchantAccounts: associatedMerchantAccounts,
+		Active:                     knownBoolPointer(plan.Active),
+	}
+
+	companyAccount := plan.CompanyAccount.ValueString()
+	updateUserInput := r.client.Management().UsersCompanyLevelApi.
+		UpdateUserDetailsInput(companyAccount, plan.ID.ValueString()).
+		UpdateCompanyUserRequest(updateCompanyUserRequest)
+	user, _, err := r.client.Management().UsersCompanyLevelApi.UpdateUserDetails(ctx, updateUserInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating company user",
+			"Could not update company user "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan = mapUserCompanyModel(companyAccount, user)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deactivates the user and removes the Terraform state on success, as Adyen does not allow deleting users.
+func (r *userCompanyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userCompanyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateUserInput := r.client.Management().UsersCompanyLevelApi.
+		UpdateUserDetailsInput(state.CompanyAccount.ValueString(), state.ID.ValueString()).
+		UpdateCompanyUserRequest(management.UpdateCompanyUserRequest{Active: common.PtrBool(false)})
+	_, httpRes, err := r.client.Management().UsersCompanyLevelApi.UpdateUserDetails(ctx, updateUserInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // The user no longer exists, so there is nothing to deactivate.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting User Company",
+			"Could not deactivate company user "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing user using an identifier of the form "<company_account>/<user_id>".
+func (r *userCompanyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	companyAccount, userID, err := splitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import identifier of the form '<company_account>/<user_id>': "+err.Error(),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the company_account and id attributes, Read fills in the remaining state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("company_account"), companyAccount)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userID)...)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func testAccCheckAdyenUserCompanyDestroy(tfstate *terraform.State) error {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	for _, rs := range tfstate.RootModule().Resources {
+		if rs.Type != "adyen_user_company" {
+			continue
+		}
+
+		// Adyen does not allow deleting users, so destroying the resource deactivates the user.
+		data := client.Management().UsersCompanyLevelApi.GetUserDetailsInput(rs.Primary.Attributes["company_account"], rs.Primary.ID)
+		user, resp, err := client.Management().UsersCompanyLevelApi.GetUserDetails(context.Background(), data)
+		if resp != nil && resp.StatusCode == 422 { // 422 Unprocessable Entity error code from Adyen if resource does not exist.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if user.GetActive() {
+			return fmt.Errorf("adyen_user_company with id: '%s' is still active", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccUserCompanyResource(t *testing.T) {
+	resourceName := "adyen_user_company.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenUserCompanyDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigUserCompany(`["WeaveAccountECOM"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "company_account", "WeaveAccount"),
+					resource.TestCheckResourceAttr(resourceName, "username", "terraform_company_user"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone_code", "Europe/London"),
+					resource.TestCheckResourceAttr(resourceName, "associated_merchant_accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "associated_merchant_accounts.*", "WeaveAccountECOM"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+					return rs.Primary.Attributes["company_account"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigUserCompany(`["WeaveAccountECOM", "WeaveAccountPOS"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "associated_merchant_accounts.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "associated_merchant_accounts.*", "WeaveAccountPOS"),
+				),
+			},
+			{
+				// An empty set removes all associated merchant accounts of the user.
+				Config: testProviderClientFromTmpl(t) + testConfigUserCompany(`[]`),
+				Check:  resource.TestCheckResourceAttr(resourceName, "associated_merchant_accounts.#", "0"),
+			},
+		},
+	})
+}
+
+func testConfigUserCompany(associatedMerchantAccounts string) string {
+	return fmt.Sprintf(`
+	resource "adyen_user_company" "test" {
+		company_account = "WeaveAccount"
+		username        = "terraform_company_user"
+		email           = "terraform.company@example.com"
+		name = {
+			first_name = "Terraform"
+			last_name  = "Company"
+		}
+		time_zone_code               = "Europe/London"
+		roles                        = ["Merchant admin"]
+		associated_merchant_accounts = %s
+	}
+`, associatedMerchantAccounts)
+}
@@ -0,0 +1,408 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userMerchantResource{}
+	_ resource.ResourceWithConfigure   = &userMerchantResource{}
+	_ resource.ResourceWithImportState = &userMerchantResource{}
+)
+
+// userMerchantResource is the resource implementation.
+type userMerchantResource struct {
+	client *adyen.APIClient
+}
+
+// NewUserMerchantResource is a helper function to simplify the provider implementation.
+func NewUserMerchantResource() resource.Resource {
+	return &userMerchantResource{}
+}
+
+// userMerchantResourceModel maps the "user_merchant" schema data for a resource.
+type userMerchantResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Username      types.String   `tfsdk:"username"`
+	Email         types.String   `tfsdk:"email"`
+	Name          *userNameModel `tfsdk:"name"`
+	TimeZoneCode  types.String   `tfsdk:"time_zone_code"`
+	Roles         types.Set      `tfsdk:"roles"`
+	AccountGroups types.Set      `tfsdk:"account_groups"`
+	Apps          types.Set      `tfsdk:"apps"`
+	Active        types.Bool     `tfsdk:"active"`
+}
+
+// userNameModel maps the name of a Customer Area user.
+type userNameModel struct {
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+}
+
+// mapUserMerchantModel maps a merchant user returned by the Adyen API to its Terraform model.
+func mapUserMerchantModel(user management.User) userMerchantResourceModel {
+	return userMerchantResourceModel{
+		ID:            types.StringValue(user.Id),
+		Username:      types.StringValue(user.Username),
+		Email:         types.StringValue(user.Email),
+		Name:          mapUserNameModel(user.Name),
+		TimeZoneCode:  types.StringValue(user.TimeZoneCode),
+		Roles:         mapStringsToSet(user.Roles),
+		AccountGroups: mapStringsToSet(user.AccountGroups),
+		Apps:          mapStringsToSet(user.Apps),
+		Active:        types.BoolPointerValue(user.Active),
+	}
+}
+
+// mapUserNameModel maps the name of a user returned by the Adyen API to its Terraform model.
+func mapUserNameModel(name *management.Name) *userNameModel {
+	if name == nil {
+		return nil
+	}
+	return &userNameModel{
+		FirstName: types.StringValue(name.FirstName),
+		LastName:  types.StringValue(name.LastName),
+	}
+}
+
+// userResourceAttributes returns the schema attributes shared by merchant and company users.
+func userResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the user.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"username": schema.StringAttribute{
+			Required:    true,
+			Description: "The user's username. Adyen does not allow changing the username, so changing it forces a new user to be created.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"email": schema.StringAttribute{
+			Required:    true,
+			Description: "The email address of the user.",
+		},
+		"name": schema.SingleNestedAttribute{
+			Required:    true,
+			Description: "The name of the user.",
+			Attributes: map[string]schema.Attribute{
+				"first_name": schema.StringAttribute{
+					Required:    true,
+					Description: "The first name of the user.",
+				},
+				"last_name": schema.StringAttribute{
+					Required:    true,
+					Description: "The last name of the user.",
+				},
+			},
+		},
+		"time_zone_code": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The tz database identifier of the time zone of the user, for example Europe/Amsterdam. If not set, Adyen uses the time zone of the account.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"roles": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The list of roles for this user.",
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"account_groups": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The list of account groups associated with this user.",
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"apps": schema.SetAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The list of apps this user has access to.",
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"active": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Indicates whether this user is active. New users are active by default.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userMerchantResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *userMerchantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_merchant"
+}
+
+// Schema defines the schema for the resource.
+func (r *userMerchantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Customer Area user of the merchant account of the provider.\n\n" +
+			"Adyen does not allow deleting users, so destroying this resource deactivates the user instead.\n\n" +
+			"To make this request, your API credential must have the following role:\n\nManagement API—Users read and write",
+		Attributes: userResourceAttributes(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userMerchantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating adyen merchant user")
+
+	// Retrieve values from the plan
+	var plan userMerchantResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, diags := mapSetToStrings(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	accountGroups, diags := mapSetToStrings(ctx, plan.AccountGroups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	createMerchantUserRequest := management.CreateMerchantUserRequest{
+		Username: plan.Username.ValueString(),
+		Email:    plan.Email.ValueString(),
+		Name: management.Name{
+			FirstName: plan.Name.FirstName.ValueString(),
+			LastName:  plan.Name.LastName.ValueString(),
+		},
+		TimeZoneCode:  knownStringPointer(plan.TimeZoneCode),
+		Roles:         roles,
+		AccountGroups: accountGroups,
+	}
+
+	createUserInput := r.client.Management().UsersMerchantLevelApi.
+		CreateNewUserInput(r.client.GetConfig().MerchantAccount).
+		CreateMerchantUserRequest(createMerchantUserRequest)
+	createUserResponse, _, err := r.client.Management().UsersMerchantLevelApi.CreateNewUser(ctx, createUserInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating merchant user",
+			"Could not create merchant user "+plan.Username.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	user := management.User(createUserResponse)
+
+	// Users are always created as active, so deactivate the user right away if requested.
+	if !plan.Active.IsUnknown() && plan.Active.ValueBool() != user.GetActive() {
+		updateUserInput := r.client.Management().UsersMerchantLevelApi.
+			UpdateUserInput(r.client.GetConfig().MerchantAccount, user.Id).
+			UpdateMerchantUserRequest(management.UpdateMerchantUserRequest{Active: plan.Active.ValueBoolPointer()})
+		user, _, err = r.client.Management().UsersMerchantLevelApi.UpdateUser(ctx, updateUserInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating merchant user",
+				"Could not set the active flag of merchant user "+createUserResponse.Id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate with attribute values
+	plan = mapUserMerchantModel(user)
+
+	// Set state with the fully populated user
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userMerchantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userMerchantResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getUserInput := r.client.Management().UsersMerchantLevelApi.GetUserDetailsInput(r.client.GetConfig().MerchantAccount, state.ID.ValueString())
+	user, httpRes, err := r.client.Management().UsersMerchantLevelApi.GetUserDetails(ctx, getUserInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the user does not exist.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User Merchant",
+			"Could not read merchant user "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = mapUserMerchantModel(user)
+
+	tflog.Debug(ctx, "Reading merchant user...")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userMerchantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating adyen merchant user")
+
+	// Retrieve values from the plan
+	var plan userMerchantResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An empty set is sent as an empty list, which clears the values in Adyen. A set that is not configured is left out of the request.
+	roles, diags := mapSetToStrings(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	accountGroups, diags := mapSetToStrings(ctx, plan.AccountGroups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updateMerchantUserRequest := management.UpdateMerchantUserRequest{
+		Email: plan.Email.ValueStringPointer(),
+		Name: &management.Name2{
+			FirstName: plan.Name.FirstName.ValueStringPointer(),
+			LastName:  plan.Name.LastName.ValueStringPointer(),
+		},
+		TimeZoneCode:  knownStringPointer(plan.TimeZoneCode),
+		Roles:         roles,
+		AccountGroups: accountGroups,
+		Active:        knownBoolPointer(plan.Active),
+	}
+
+	updateUserInput := r.client.Management().UsersMerchantLevelApi.
+		UpdateUserInput(r.client.GetConfig().MerchantAccount, plan.ID.ValueString()).
+		UpdateMerchantUserRequest(updateMerchantUserRequest)
+	user, _, err := r.client.Management().UsersMerchantLevelApi.UpdateUser(ctx, updateUserInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating merchant user",
+			"Could not update merchant user "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan = mapUserMerchantModel(user)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deactivates the user and removes the Terraform state on success, as Adyen does not allow deleting users.
+func (r *userMerchantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userMerchantResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateUserInput := r.client.Management().UsersMerchantLevelApi.
+		UpdateUserInput(r.client.GetConfig().MerchantAccount, state.ID.ValueString()).
+		UpdateMerchantUserRequest(management.UpdateMerchantUserRequest{Active: common.PtrBool(false)})
+	_, httpRes, err := r.client.Management().UsersMerchantLevelApi.UpdateUser(ctx, updateUserInput)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // The user no longer exists, so there is nothing to deactivate.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting User Merchant",
+			"Could not deactivate merchant user "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing user using an identifier of the form "<merchant_account>/<user_id>".
+func (r *userMerchantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	merchantAccount, userID, err := splitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import identifier of the form '<merchant_account>/<user_id>': "+err.Error(),
+		)
+		return
+	}
+
+	// The merchant user resource is bound to the merchant account of the provider.
+	if merchantAccount != r.client.GetConfig().MerchantAccount {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Merchant account '%s' does not match the merchant account configured for the provider.", merchantAccount),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the id attribute, Read fills in the remaining state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userID)...)
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"testing"
+)
+
+func testAccCheckAdyenUserMerchantDestroy(tfstate *terraform.State) error {
+	suite := new(AcceptanceSuite)
+	suite.SetupSuite()
+	client := suite.client
+
+	for _, rs := range tfstate.RootModule().Resources {
+		if rs.Type != "adyen_user_merchant" {
+			continue
+		}
+
+		// Adyen does not allow deleting users, so destroying the resource deactivates the user.
+		data := client.Management().UsersMerchantLevelApi.GetUserDetailsInput(client.GetConfig().MerchantAccount, rs.Primary.ID)
+		user, resp, err := client.Management().UsersMerchantLevelApi.GetUserDetails(context.Background(), data)
+		if resp != nil && resp.StatusCode == 422 { // 422 Unprocessable Entity error code from Adyen if resource does not exist.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if user.GetActive() {
+			return fmt.Errorf("adyen_user_merchant with id: '%s' is still active", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestAccUserMerchantResource(t *testing.T) {
+	resourceName := "adyen_user_merchant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenUserMerchantDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigUserMerchant("terraform.user@example.com", `["Merchant admin", "Manage payments"]`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "username", "terraform_merchant_user"),
+					resource.TestCheckResourceAttr(resourceName, "email", "terraform.user@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name.first_name", "Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "name.last_name", "User"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone_code", "Europe/Amsterdam"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "Merchant admin"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+					return os.Getenv("ADYEN_API_MERCHANT_ACCOUNT") + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigUserMerchant("terraform.updated@example.com", `["Merchant admin", "Manage payments"]`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "email", "terraform.updated@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+				),
+			},
+			{
+				// An empty set removes all roles of the user.
+				Config: testProviderClientFromTmpl(t) + testConfigUserMerchant("terraform.updated@example.com", `[]`, false),
+				Check:  resource.TestCheckResourceAttr(resourceName, "roles.#", "0"),
+			},
+		},
+	})
+}
+
+func testConfigUserMerchant(email string, roles string, active bool) string {
+	return fmt.Sprintf(`
+	resource "adyen_user_merchant" "test" {
+		username = "terraform_merchant_user"
+		email    = "%s"
+		name = {
+			first_name = "Terraform"
+			last_name  = "User"
+		}
+		roles  = %s
+		active = %t
+	}
+`, email, roles, active)
+}
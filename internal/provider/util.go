@@ -1,10 +1,128 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/common"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"slices"
+	"strings"
 )
 
+// listPageSize is the maximum number of items the Management API returns per page of a list endpoint.
+const listPageSize int32 = 100
+
+// splitImportID splits a composite import identifier of the form "<account>/<id>" into its parts.
+func splitImportID(importID string) (string, string, error) {
+	parts := strings.Split(importID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("got '%s'", importID)
+	}
+	return parts[0], parts[1], nil
+}
+
+// knownStringPointer returns nil for a null or unknown value, so Optional+Computed attributes that are not configured are left out of a request.
+func knownStringPointer(value types.String) *string {
+	if value.IsUnknown() {
+		return nil
+	}
+	return value.ValueStringPointer()
+}
+
+// clearableStringPointer returns an empty string for a null value, so an update request clears an Optional attribute that is
+// removed from the configuration, as Adyen keeps the value of a field that is left out.
+func clearableStringPointer(value types.String) *string {
+	if value.IsNull() {
+		return common.PtrString("")
+	}
+	return value.ValueStringPointer()
+}
+
+// mapOptionalString maps an optional string returned by the Adyen API, treating an empty string like a cleared value.
+func mapOptionalString(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+// mapStringsToSet maps a list of strings returned by the Adyen API to a Terraform set, treating a missing list as empty.
+func mapStringsToSet(input []string) types.Set {
+	elements := make([]attr.Value, 0, len(input))
+	for _, v := range input {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// mapSetToStrings maps a Terraform set of strings to an Adyen API request, returning nil for a null or unknown set and an empty,
+// non-nil slice for an empty set, so the request clears the list.
+func mapSetToStrings(ctx context.Context, input types.Set) ([]string, diag.Diagnostics) {
+	if input.IsNull() || input.IsUnknown() {
+		return nil, nil
+	}
+	output := []string{}
+	diags := input.ElementsAs(ctx, &output, false)
+	return output, diags
+}
+
+// knownBoolPointer returns nil for a null or unknown value, so Optional+Computed attributes that are not configured are left out of a request.
+func knownBoolPointer(value types.Bool) *bool {
+	if value.IsUnknown() {
+		return nil
+	}
+	return value.ValueBoolPointer()
+}
+
+// rotationTriggerAttribute returns the rotation_trigger attribute of a resource that generates a key. The resource is replaced,
+// and so generates a new key, whenever a value of the map changes.
+func rotationTriggerAttribute(key string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		Description: "Arbitrary map of values that, when changed, will generate a new " + key + ".",
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// checkGeneratedKeyOwner handles the response of looking up the webhook or API credential a generated key belongs to. Adyen responds
+// with 422 Unprocessable Entity if it no longer exists, in which case the key is removed from state. It returns false if the key was
+// removed or the lookup failed.
+func checkGeneratedKeyOwner(ctx context.Context, resp *resource.ReadResponse, httpRes *http.Response, err error, summary string, owner string) bool {
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity {
+		resp.State.RemoveResource(ctx)
+		return false
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			"Could not read "+owner+", unexpected error: "+err.Error(),
+		)
+		return false
+	}
+	return true
+}
+
+// deleteGeneratedKey removes a generated key from the Terraform state. Adyen has no endpoint to revoke generated keys,
+// so the key stays valid until a new one is generated.
+func deleteGeneratedKey(ctx context.Context, key string) {
+	tflog.Debug(ctx, "Removing "+key+" from state")
+}
+
 //TODO: generalize these functions
 
 func mapWebhooksAdditionalSettingsEventCodes(input []string) []attr.Value {
@@ -23,6 +141,125 @@ func mapWebhooksAdditionalSettingsProperties(input map[string]bool) map[string]a
 	return output
 }
 
+// mapWebhooksAdditionalSettingsRequest maps the additional_settings of a webhook plan to an Adyen API request.
+// Attributes that are not configured are left out, so Adyen keeps their current values, while an empty list or map clears them.
+// Adyen does not accept exclude_event_codes in a request, so it is never sent.
+func mapWebhooksAdditionalSettingsRequest(ctx context.Context, additionalSettings types.Object) (*management.AdditionalSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if additionalSettings.IsNull() || additionalSettings.IsUnknown() {
+		return nil, diags
+	}
+
+	request := &management.AdditionalSettings{}
+	attributes := additionalSettings.Attributes()
+
+	if includeEventCodes, ok := attributes["include_event_codes"].(types.List); ok && !includeEventCodes.IsNull() && !includeEventCodes.IsUnknown() {
+		request.IncludeEventCodes = []string{}
+		diags.Append(includeEventCodes.ElementsAs(ctx, &request.IncludeEventCodes, false)...)
+	}
+
+	if properties, ok := attributes["properties"].(types.Map); ok && !properties.IsNull() && !properties.IsUnknown() {
+		propertiesRequest := map[string]bool{}
+		diags.Append(properties.ElementsAs(ctx, &propertiesRequest, false)...)
+		request.Properties = &propertiesRequest
+	}
+
+	return request, diags
+}
+
+// webhookAdditionalSettingsConfiguredKey is the private state key that lists the additional settings of a webhook that are configured,
+// so removing one of them from the configuration clears it instead of keeping the value from state.
+const webhookAdditionalSettingsConfiguredKey = "additional_settings_configured"
+
+// webhookClearedAdditionalSettings are the values planned for additional settings that are removed from the configuration.
+var webhookClearedAdditionalSettings = map[string]attr.Value{
+	"include_event_codes": types.ListValueMust(types.StringType, []attr.Value{}),
+	"properties":          types.MapValueMust(types.BoolType, map[string]attr.Value{}),
+}
+
+// configuredWebhookAdditionalSettings returns the names of the additional settings of a webhook that are set in the configuration.
+func configuredWebhookAdditionalSettings(ctx context.Context, config tfsdk.Config, webhookPath path.Path) ([]string, diag.Diagnostics) {
+	var webhook types.Object
+	diags := config.GetAttribute(ctx, webhookPath, &webhook)
+	if diags.HasError() || webhook.IsNull() || webhook.IsUnknown() {
+		return nil, diags
+	}
+	additionalSettings, ok := webhook.Attributes()["additional_settings"].(types.Object)
+	if !ok || additionalSettings.IsNull() || additionalSettings.IsUnknown() {
+		return nil, diags
+	}
+
+	var configured []string
+	for name, value := range additionalSettings.Attributes() {
+		if _, clearable := webhookClearedAdditionalSettings[name]; clearable && !value.IsNull() {
+			configured = append(configured, name)
+		}
+	}
+	slices.Sort(configured)
+	return configured, diags
+}
+
+// configuredWebhookAdditionalSettingsPrivateState returns the private state that records the additional settings of a webhook
+// that are configured, to be stored under webhookAdditionalSettingsConfiguredKey.
+func configuredWebhookAdditionalSettingsPrivateState(ctx context.Context, config tfsdk.Config, webhookPath path.Path) ([]byte, diag.Diagnostics) {
+	configured, diags := configuredWebhookAdditionalSettings(ctx, config, webhookPath)
+	if diags.HasError() {
+		return nil, diags
+	}
+	value, err := json.Marshal(configured)
+	if err != nil {
+		diags.AddError("Error recording webhook additional settings", "Could not record the configured additional settings: "+err.Error())
+	}
+	return value, diags
+}
+
+// modifyWebhookAdditionalSettingsPlan plans to clear the additional settings of a webhook that were configured and are removed
+// from the configuration, as they would otherwise keep their value from state. Settings that were never configured, for example
+// because the webhook was imported, keep the values Adyen has.
+func modifyWebhookAdditionalSettingsPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, webhookPath path.Path) {
+	// Nothing to clear when the webhook is added or removed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	previous, diags := req.Private.GetKey(ctx, webhookAdditionalSettingsConfiguredKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || previous == nil {
+		return
+	}
+	var previouslyConfigured []string
+	if err := json.Unmarshal(previous, &previouslyConfigured); err != nil {
+		resp.Diagnostics.AddError("Error reading webhook additional settings", "Could not read the configured additional settings: "+err.Error())
+		return
+	}
+
+	configured, diags := configuredWebhookAdditionalSettings(ctx, req.Config, webhookPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, name := range previouslyConfigured {
+		if cleared, ok := webhookClearedAdditionalSettings[name]; ok && !slices.Contains(configured, name) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, webhookPath.AtName("additional_settings").AtName(name), cleared)...)
+		}
+	}
+}
+
+// mapWebhooksCompanyFilterMerchantAccountsToString maps the filter_merchant_accounts of a company webhook plan to an Adyen API request.
+// An empty list is sent as an empty array instead of being left out, so switching to allAccounts clears the merchant accounts stored by Adyen.
+func mapWebhooksCompanyFilterMerchantAccountsToString(input types.List) []string {
+	if input.IsNull() || input.IsUnknown() {
+		return nil
+	}
+	output := []string{}
+	for _, elem := range input.Elements() {
+		if v, ok := elem.(types.String); ok {
+			output = append(output, v.ValueString())
+		}
+	}
+	return output
+}
+
 func mapWebhooksCompanyFilterMerchantAccountsFromString(input []string) []attr.Value {
 	output := make([]attr.Value, 0, len(input))
 	for _, v := range input {
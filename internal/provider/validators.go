@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Allowed values of the enumerated webhook attributes.
+var (
+	webhookTypes = []string{
+		"standard",
+		"account-settings-notification",
+		"banktransfer-notification",
+		"boletobancario-notification",
+		"directdebit-notification",
+		"ach-notification-of-change-notification",
+		"pending-notification",
+		"ideal-notification",
+		"ideal-pending-notification",
+		"report-notification",
+		"rreq-notification",
+	}
+	webhookCommunicationFormats       = []string{"soap", "http", "json"}
+	webhookEncryptionProtocols        = []string{"TLSv1.3", "TLSv1.2", "HTTP"}
+	webhookFilterMerchantAccountTypes = []string{"allAccounts", "includeAccounts", "excludeAccounts"}
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ resource.ConfigValidator = webhookFilterMerchantAccountsValidator{}
+	_ resource.ConfigValidator = webhookPopulateSoapActionHeaderValidator{}
+)
+
+// webhookFilterMerchantAccountsValidator checks that filter_merchant_accounts is empty when all merchant accounts are selected,
+// and set when merchant accounts are included or excluded.
+type webhookFilterMerchantAccountsValidator struct {
+	webhookPath path.Path
+}
+
+// Description describes the validation in plain text formatting.
+func (v webhookFilterMerchantAccountsValidator) Description(_ context.Context) string {
+	return "filter_merchant_accounts must be empty if filter_merchant_account_type is allAccounts, and must not be empty otherwise"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v webhookFilterMerchantAccountsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation.
+func (v webhookFilterMerchantAccountsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var filterMerchantAccountType types.String
+	var filterMerchantAccounts types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.webhookPath.AtName("filter_merchant_account_type"), &filterMerchantAccountType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.webhookPath.AtName("filter_merchant_accounts"), &filterMerchantAccounts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values that are not known yet are validated again during apply.
+	if filterMerchantAccountType.IsNull() || filterMerchantAccountType.IsUnknown() || filterMerchantAccounts.IsUnknown() {
+		return
+	}
+
+	filterMerchantAccountsPath := v.webhookPath.AtName("filter_merchant_accounts")
+	if filterMerchantAccountType.ValueString() == "allAccounts" {
+		if len(filterMerchantAccounts.Elements()) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				filterMerchantAccountsPath,
+				"Invalid Attribute Combination",
+				"filter_merchant_accounts must be empty if filter_merchant_account_type is allAccounts.",
+			)
+		}
+		return
+	}
+
+	if len(filterMerchantAccounts.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			filterMerchantAccountsPath,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("filter_merchant_accounts must not be empty if filter_merchant_account_type is %s.", filterMerchantAccountType.ValueString()),
+		)
+	}
+}
+
+// webhookPopulateSoapActionHeaderValidator checks that populate_soap_action_header is only enabled for webhooks using the soap communication format.
+type webhookPopulateSoapActionHeaderValidator struct {
+	webhookPath path.Path
+}
+
+// Description describes the validation in plain text formatting.
+func (v webhookPopulateSoapActionHeaderValidator) Description(_ context.Context) string {
+	return "populate_soap_action_header can only be enabled if communication_format is soap"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v webhookPopulateSoapActionHeaderValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation.
+func (v webhookPopulateSoapActionHeaderValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var populateSoapActionHeader types.Bool
+	var communicationFormat types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.webhookPath.AtName("populate_soap_action_header"), &populateSoapActionHeader)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.webhookPath.AtName("communication_format"), &communicationFormat)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values that are not known yet are validated again during apply.
+	if !populateSoapActionHeader.ValueBool() || communicationFormat.IsNull() || communicationFormat.IsUnknown() {
+		return
+	}
+
+	if communicationFormat.ValueString() != "soap" {
+		resp.Diagnostics.AddAttributeError(
+			v.webhookPath.AtName("populate_soap_action_header"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("populate_soap_action_header only applies to the soap communication format, got communication_format %s.", communicationFormat.ValueString()),
+		)
+	}
+}
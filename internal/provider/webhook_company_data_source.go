@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &webhookCompanyDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookCompanyDataSource{}
+)
+
+// webhookCompanyDataSource is the data source implementation.
+type webhookCompanyDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewWebhooksCompanyDataSource is a helper function to simplify the provider implementation.
+func NewWebhooksCompanyDataSource() datasource.DataSource {
+	return &webhookCompanyDataSource{}
+}
+
+// webhooksCompanyDataSourceModel maps the "webhooks_company" schema data for a data source.
+type webhooksCompanyDataSourceModel struct {
+	CompanyAccount  types.String                           `tfsdk:"company_account"`
+	ID              types.String                           `tfsdk:"id"`
+	WebhooksCompany *webhooksCompanyDataSourceWebhookModel `tfsdk:"webhooks_company"`
+}
+
+// webhooksCompanyDataSourceWebhookModel maps a company webhook for the data sources. Adyen never returns the password of a webhook, so unlike the resource it has none.
+type webhooksCompanyDataSourceWebhookModel struct {
+	ID                              types.String `tfsdk:"id"`
+	Type                            types.String `tfsdk:"type"`
+	URL                             types.String `tfsdk:"url"`
+	Username                        types.String `tfsdk:"username"`
+	Description                     types.String `tfsdk:"description"`
+	HasPassword                     types.Bool   `tfsdk:"has_password"`
+	Active                          types.Bool   `tfsdk:"active"`
+	HasError                        types.Bool   `tfsdk:"has_error"`
+	EncryptionProtocol              types.String `tfsdk:"encryption_protocol"`
+	CommunicationFormat             types.String `tfsdk:"communication_format"`
+	AcceptsExpiredCertificate       types.Bool   `tfsdk:"accepts_expired_certificate"`
+	AcceptsSelfSignedCertificate    types.Bool   `tfsdk:"accepts_self_signed_certificate"`
+	AcceptsUntrustedRootCertificate types.Bool   `tfsdk:"accepts_untrusted_root_certificate"`
+	CertificateAlias                types.String `tfsdk:"certificate_alias"`
+	PopulateSoapActionHeader        types.Bool   `tfsdk:"populate_soap_action_header"`
+	Links                           types.Object `tfsdk:"links"`
+	AdditionalSettings              types.Object `tfsdk:"additional_settings"`
+	FilterMerchantAccountType       types.String `tfsdk:"filter_merchant_account_type"`
+	FilterMerchantAccounts          types.List   `tfsdk:"filter_merchant_accounts"`
+}
+
+// mapWebhooksCompanyDataSourceWebhookModel maps a company webhook returned by the Adyen API to its data source model.
+func mapWebhooksCompanyDataSourceWebhookModel(webhook management.Webhook) webhooksCompanyDataSourceWebhookModel {
+	model := mapWebhooksCompanyModel(webhook)
+	return webhooksCompanyDataSourceWebhookModel{
+		ID:                              model.ID,
+		Type:                            model.Type,
+		URL:                             model.URL,
+		Username:                        model.Username,
+		Description:                     model.Description,
+		HasPassword:                     model.HasPassword,
+		Active:                          model.Active,
+		HasError:                        model.HasError,
+		EncryptionProtocol:              model.EncryptionProtocol,
+		CommunicationFormat:             model.CommunicationFormat,
+		AcceptsExpiredCertificate:       model.AcceptsExpiredCertificate,
+		AcceptsSelfSignedCertificate:    model.AcceptsSelfSignedCertificate,
+		AcceptsUntrustedRootCertificate: model.AcceptsUntrustedRootCertificate,
+		CertificateAlias:                model.CertificateAlias,
+		PopulateSoapActionHeader:        model.PopulateSoapActionHeader,
+		Links:                           model.Links,
+		AdditionalSettings:              model.AdditionalSettings,
+		FilterMerchantAccountType:       model.FilterMerchantAccountType,
+		FilterMerchantAccounts:          model.FilterMerchantAccounts,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webhookCompanyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *webhookCompanyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks_company"
+}
+
+// Schema defines the schema for the data source.
+func (d *webhookCompanyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns a webhook configured for a company account.\n\n" +
+			"To make this request, your API credential must have one of the following roles:\n\nManagement API—Webhooks read\nManagement API—Webhooks read and write",
+		Attributes: map[string]schema.Attribute{
+			"company_account": schema.StringAttribute{
+				Required:    true,
+				Description: "The company account of your Adyen Dashboard Environment.",
+			},
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the webhook.",
+			},
+			"webhooks_company": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The webhook configuration.",
+				Attributes:  webhooksCompanyDataSourceAttributes(),
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *webhookCompanyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state webhooksCompanyDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading company webhook data source...")
+
+	getWebhookInput := d.client.Management().WebhooksCompanyLevelApi.GetWebhookInput(state.CompanyAccount.ValueString(), state.ID.ValueString())
+	webhook, _, err := d.client.Management().WebhooksCompanyLevelApi.GetWebhook(ctx, getWebhookInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webhooks Company",
+			"Could not read company webhook "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	webhooksCompany := mapWebhooksCompanyDataSourceWebhookModel(webhook)
+	state.WebhooksCompany = &webhooksCompany
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// webhooksCompanyDataSourceAttributes returns the computed attributes of a company webhook, shared by the company webhook data sources.
+func webhooksCompanyDataSourceAttributes() map[string]schema.Attribute {
+	attributes := webhooksDataSourceAttributes()
+	attributes["links"] = schema.SingleNestedAttribute{
+		Computed: true,
+		Attributes: map[string]schema.Attribute{
+			"self":          webhooksDataSourceLinkAttribute("The API URL to the webhook itself."),
+			"generate_hmac": webhooksDataSourceLinkAttribute("The API URL to generate an HMAC key for the webhook."),
+			"company":       webhooksDataSourceLinkAttribute("The API URL to the company account associated with the webhook."),
+			"test_webhook":  webhooksDataSourceLinkAttribute("The API URL to test the webhook."),
+		},
+	}
+	attributes["filter_merchant_account_type"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Shows how merchant accounts are filtered when configuring the webhook: allAccounts, includeAccounts or excludeAccounts.",
+	}
+	attributes["filter_merchant_accounts"] = schema.ListAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "A list of merchant account names that are included or excluded from receiving the webhook.",
+	}
+	return attributes
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccWebhookCompanyDataSource(t *testing.T) {
+	resourceName := "adyen_webhooks_company.test"
+	dataSourceName := "data.adyen_webhooks_company.test"
+	listDataSourceName := "data.adyen_webhooks_company_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenWebhookCompanyDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigCreateCompanyWebhook() + testConfigCompanyWebhookDataSources(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "webhooks_company.id", resourceName, "webhooks_company.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks_company.type", "standard"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks_company.filter_merchant_account_type", "includeAccounts"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks_company.filter_merchant_accounts.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "webhooks_company.links.company.href"),
+					resource.TestCheckTypeSetElemNestedAttrs(listDataSourceName, "webhooks_company.*", map[string]string{
+						"url":                          "https://webhook.site/cb798fb3-7092-4cab-986b-f416fb04f92e",
+						"filter_merchant_account_type": "includeAccounts",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testConfigCompanyWebhookDataSources() string {
+	return `
+	data "adyen_webhooks_company" "test" {
+		company_account = adyen_webhooks_company.test.company_account
+		id              = adyen_webhooks_company.test.webhooks_company.id
+	}
+
+	data "adyen_webhooks_company_list" "test" {
+		company_account = adyen_webhooks_company.test.company_account
+		depends_on      = [adyen_webhooks_company.test]
+	}
+`
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &webhookCompanyListDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookCompanyListDataSource{}
+)
+
+// webhookCompanyListDataSource is the data source implementation.
+type webhookCompanyListDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewWebhooksCompanyListDataSource is a helper function to simplify the provider implementation.
+func NewWebhooksCompanyListDataSource() datasource.DataSource {
+	return &webhookCompanyListDataSource{}
+}
+
+// webhooksCompanyListDataSourceModel maps the "webhooks_company_list" schema data for a data source.
+type webhooksCompanyListDataSourceModel struct {
+	CompanyAccount  types.String                            `tfsdk:"company_account"`
+	WebhooksCompany []webhooksCompanyDataSourceWebhookModel `tfsdk:"webhooks_company"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webhookCompanyListDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *webhookCompanyListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks_company_list"
+}
+
+// Schema defines the schema for the data source.
+func (d *webhookCompanyListDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns all webhooks configured for a company account.\n\n" +
+			"To make this request, your API credential must have one of the following roles:\n\nManagement API—Webhooks read\nManagement API—Webhooks read and write",
+		Attributes: map[string]schema.Attribute{
+			"company_account": schema.StringAttribute{
+				Required:    true,
+				Description: "The company account of your Adyen Dashboard Environment.",
+			},
+			"webhooks_company": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The webhook configurations of the company account.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: webhooksCompanyDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *webhookCompanyListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state webhooksCompanyListDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading company webhooks data source...")
+
+	state.WebhooksCompany = []webhooksCompanyDataSourceWebhookModel{}
+
+	// Adyen returns at most 100 webhooks per page, so keep fetching until all pages are read.
+	for page := int32(1); ; page++ {
+		listWebhooksInput := d.client.Management().WebhooksCompanyLevelApi.
+			ListAllWebhooksInput(state.CompanyAccount.ValueString()).
+			PageNumber(page).
+			PageSize(listPageSize)
+		listWebhooksResponse, _, err := d.client.Management().WebhooksCompanyLevelApi.ListAllWebhooks(ctx, listWebhooksInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Webhooks Company",
+				"Could not list company webhooks, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		for _, webhook := range listWebhooksResponse.Data {
+			state.WebhooksCompany = append(state.WebhooksCompany, mapWebhooksCompanyDataSourceWebhookModel(webhook))
+		}
+
+		if page >= listWebhooksResponse.PagesTotal {
+			break
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
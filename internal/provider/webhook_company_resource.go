@@ -5,21 +5,29 @@ import (
 	"fmt"
 	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
 	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"strings"
+	"net/http"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &webhookCompanyResource{}
-	_ resource.ResourceWithConfigure = &webhookCompanyResource{}
+	_ resource.Resource                     = &webhookCompanyResource{}
+	_ resource.ResourceWithConfigure        = &webhookCompanyResource{}
+	_ resource.ResourceWithImportState      = &webhookCompanyResource{}
+	_ resource.ResourceWithConfigValidators = &webhookCompanyResource{}
+	_ resource.ResourceWithModifyPlan       = &webhookCompanyResource{}
 )
 
 // webhookResource is the resource implementation.
@@ -61,6 +69,52 @@ type webhooksCompanyModel struct {
 	FilterMerchantAccounts          types.List   `tfsdk:"filter_merchant_accounts"`
 }
 
+// mapWebhooksCompanyModel maps a company webhook returned by the Adyen API to its Terraform model.
+// Adyen never returns the password of a webhook, so it is left for the caller to fill in.
+func mapWebhooksCompanyModel(webhook management.Webhook) webhooksCompanyModel {
+	links := webhook.GetLinks()
+	additionalSettings := webhook.GetAdditionalSettings()
+
+	includeEventCodes := mapWebhooksAdditionalSettingsEventCodes(additionalSettings.IncludeEventCodes)
+	excludeEventCodes := mapWebhooksAdditionalSettingsEventCodes(additionalSettings.ExcludeEventCodes)
+	var properties map[string]attr.Value
+	if additionalSettings.Properties != nil {
+		properties = mapWebhooksAdditionalSettingsProperties(*additionalSettings.Properties)
+	}
+
+	return webhooksCompanyModel{
+		ID:                              types.StringPointerValue(webhook.Id),
+		Description:                     types.StringPointerValue(webhook.Description),
+		Type:                            types.StringValue(webhook.Type),
+		URL:                             types.StringValue(webhook.Url),
+		Username:                        types.StringPointerValue(webhook.Username),
+		HasPassword:                     types.BoolPointerValue(webhook.HasPassword),
+		Password:                        types.StringNull(),
+		Active:                          types.BoolValue(webhook.Active),
+		HasError:                        types.BoolPointerValue(webhook.HasError),
+		EncryptionProtocol:              types.StringPointerValue(webhook.EncryptionProtocol),
+		CommunicationFormat:             types.StringValue(webhook.CommunicationFormat),
+		AcceptsExpiredCertificate:       types.BoolPointerValue(webhook.AcceptsExpiredCertificate),
+		AcceptsSelfSignedCertificate:    types.BoolPointerValue(webhook.AcceptsSelfSignedCertificate),
+		AcceptsUntrustedRootCertificate: types.BoolPointerValue(webhook.AcceptsUntrustedRootCertificate),
+		PopulateSoapActionHeader:        types.BoolPointerValue(webhook.PopulateSoapActionHeader),
+		CertificateAlias:                types.StringPointerValue(webhook.CertificateAlias),
+		Links: types.ObjectValueMust(linksAttributeMapCompany, mapWebhooksLinksCompany(
+			links.Self.Href,
+			links.GenerateHmac.Href,
+			links.GetCompany().Href,
+			links.TestWebhook.Href),
+		),
+		AdditionalSettings: types.ObjectValueMust(additionalSettingsAttributeMap, map[string]attr.Value{
+			"include_event_codes": types.ListValueMust(types.StringType, includeEventCodes),
+			"exclude_event_codes": types.ListValueMust(types.StringType, excludeEventCodes),
+			"properties":          types.MapValueMust(types.BoolType, properties),
+		}),
+		FilterMerchantAccountType: types.StringPointerValue(webhook.FilterMerchantAccountType),
+		FilterMerchantAccounts:    types.ListValueMust(types.StringType, mapWebhooksCompanyFilterMerchantAccountsFromString(webhook.FilterMerchantAccounts)),
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *webhookCompanyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -92,7 +146,10 @@ func (r *webhookCompanyResource) Schema(ctx context.Context, req resource.Schema
 		Attributes: map[string]schema.Attribute{
 			"company_account": schema.StringAttribute{
 				Required:    true,
-				Description: "The company account of your Adyen Dashboard Environment.",
+				Description: "The company account of your Adyen Dashboard Environment. Changing it forces a new webhook to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"webhooks_company": schema.SingleNestedAttribute{
 				Description: "Subscribe to receive webhook notifications about events related to your company account.\n\n" +
@@ -112,7 +169,14 @@ func (r *webhookCompanyResource) Schema(ctx context.Context, req resource.Schema
 						Description: "The type of webhook that is being created. Possible values are:\n\nstandard\naccount-settings-notification\n" +
 							"banktransfer-notification\nboletobancario-notification\ndirectdebit-notification\nach-notification-of-change-notification\n" +
 							"pending-notification\nideal-notification\nideal-pending-notification\nreport-notification\nrreq-notification\n" +
-							"Find out more about standard notification webhooks and other types of notifications.",
+							"Find out more about standard notification webhooks and other types of notifications.\n" +
+							"Adyen does not allow changing the type of an existing webhook, so changing it forces a new webhook to be created.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(webhookTypes...),
+						},
 					},
 					"url": schema.StringAttribute{
 						Required:    true,
@@ -138,6 +202,9 @@ func (r *webhookCompanyResource) Schema(ctx context.Context, req resource.Schema
 					"communication_format": schema.StringAttribute{
 						Required:    true,
 						Description: "Format or protocol for receiving webhooks. Possible values:\n\nsoap\nhttp\njson",
+						Validators: []validator.String{
+							stringvalidator.OneOf(webhookCommunicationFormats...),
+						},
 					},
 					"description": schema.StringAttribute{
 						Computed:    true,
@@ -149,6 +216,12 @@ func (r *webhookCompanyResource) Schema(ctx context.Context, req resource.Schema
 						Description: "SSL version to access the public webhook URL specified in the url field. " +
 							"Possible values:\n\nTLSv1.3\nTLSv1.2\n & HTTP. HTTP is Only allowed on Test environment.\n" +
 							"If not specified, the webhook will use sslVersion: TLSv1.2.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(webhookEncryptionProtocols...),
+						},
 					},
 					"has_error": schema.BoolAttribute{
 						Computed:    true,
@@ -209,26 +282,44 @@ func (r *webhookCompanyResource) Schema(ctx context.Context, req resource.Schema
 						},
 					},
 					"additional_settings": schema.SingleNestedAttribute{
-						Computed:    true,
-						Description: "Additional shopper and transaction information to be included in your standard notifications.",
+						Optional: true,
+						Computed: true,
+						Description: "Additional shopper and transaction information to be included in your standard notifications. " +
+							"Removing properties or include_event_codes from the configuration clears them, " +
+							"while settings that were never configured, for example of an imported webhook, keep the values set in Adyen.",
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
 						Attributes: map[string]schema.Attribute{
 							"properties": schema.MapAttribute{
+								Optional:    true,
 								Computed:    true,
 								ElementType: types.BoolType,
 								Description: "Object containing boolean key-value pairs. " +
 									"The key can be any standard webhook additional setting, and the value indicates if the setting is enabled. " +
 									"For example, captureDelayHours: true means the standard notifications you get will contain the " +
 									"number of hours remaining until the payment will be captured.",
+								PlanModifiers: []planmodifier.Map{
+									mapplanmodifier.UseStateForUnknown(),
+								},
 							},
 							"include_event_codes": schema.ListAttribute{
+								Optional:    true,
 								Computed:    true,
 								ElementType: types.StringType,
 								Description: "Object containing list of event codes for which the notification will be sent.",
+								PlanModifiers: []planmodifier.List{
+									listplanmodifier.UseStateForUnknown(),
+								},
 							},
 							"exclude_event_codes": schema.ListAttribute{
 								Computed:    true,
 								ElementType: types.StringType,
-								Description: "Object containing list of event codes for which the notification will NOT be sent.",
+								Description: "Object containing list of event codes for which the notification will NOT be sent. " +
+									"Read-only, as the Adyen API does not accept excluded event codes when creating or updating a webhook.",
+								PlanModifiers: []planmodifier.List{
+									listplanmodifier.UseStateForUnknown(),
+								},
 							},
 						},
 					},
@@ -238,6 +329,9 @@ func (r *webhookCompanyResource) Schema(ctx context.Context, req resource.Schema
 							"Possible values:\n\nallAccounts : Includes all merchant accounts, and does not require specifying " +
 							"filterMerchantAccounts.\nincludeAccounts : The webhook is configured for the merchant accounts listed in filterMerchantAccounts.\n" +
 							"excludeAccounts : The webhook is not configured for the merchant accounts listed in filterMerchantAccounts.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(webhookFilterMerchantAccountTypes...),
+						},
 					},
 					"filter_merchant_accounts": schema.ListAttribute{
 						ElementType: types.StringType,
@@ -253,6 +347,19 @@ func (r *webhookCompanyResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+// ConfigValidators returns the validations that span multiple attributes of the webhook.
+func (r *webhookCompanyResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		webhookFilterMerchantAccountsValidator{webhookPath: path.Root("webhooks_company")},
+		webhookPopulateSoapActionHeaderValidator{webhookPath: path.Root("webhooks_company")},
+	}
+}
+
+// ModifyPlan clears the additional settings that are removed from the configuration.
+func (r *webhookCompanyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWebhookAdditionalSettingsPlan(ctx, req, resp, path.Root("webhooks_company"))
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *webhookCompanyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "Creating adyen company webhook")
@@ -278,25 +385,22 @@ func (r *webhookCompanyResource) Create(ctx context.Context, req resource.Create
 		Url:                             plan.WebhooksCompany.URL.ValueString(),
 		Username:                        plan.WebhooksCompany.Username.ValueStringPointer(),
 		FilterMerchantAccountType:       plan.WebhooksCompany.FilterMerchantAccountType.ValueString(),
+		FilterMerchantAccounts:          mapWebhooksCompanyFilterMerchantAccountsToString(plan.WebhooksCompany.FilterMerchantAccounts),
+		EncryptionProtocol:              knownStringPointer(plan.WebhooksCompany.EncryptionProtocol),
 	}
 
-	var filterMerchantAccounts []string
-	if len(plan.WebhooksCompany.FilterMerchantAccounts.Elements()) > 0 {
-		for _, elem := range plan.WebhooksCompany.FilterMerchantAccounts.Elements() {
-			// Trimming the string is (unfortunately) necessary for Adyen API to work correctly, at this moment.
-			trimmedElem := strings.Trim(elem.String(), "\"")
-			filterMerchantAccounts = append(filterMerchantAccounts, trimmedElem)
-		}
+	additionalSettings, diags := mapWebhooksAdditionalSettingsRequest(ctx, plan.WebhooksCompany.AdditionalSettings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	// Add parsed filterMerchantAccounts from plan
-	createCompanyWebhookRequest.FilterMerchantAccounts = filterMerchantAccounts
-	companyAccount := strings.Trim(plan.CompanyAccount, "\"")
+	createCompanyWebhookRequest.AdditionalSettings = additionalSettings
 
 	// Create a new company webhook
 	webhookCompanyCreateRequest := r.client.
 		Management().
 		WebhooksCompanyLevelApi.
-		SetUpWebhookInput(companyAccount).
+		SetUpWebhookInput(plan.CompanyAccount).
 		CreateCompanyWebhookRequest(*createCompanyWebhookRequest)
 	webhookCompanyCreateResponse, _, err := r.client.
 		Management().
@@ -310,49 +414,16 @@ func (r *webhookCompanyResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	includeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookCompanyCreateResponse.AdditionalSettings.IncludeEventCodes)
-	excludeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookCompanyCreateResponse.AdditionalSettings.ExcludeEventCodes)
-	var properties map[string]attr.Value
-	if webhookCompanyCreateResponse.AdditionalSettings.Properties != nil {
-		properties = mapWebhooksAdditionalSettingsProperties(*webhookCompanyCreateResponse.AdditionalSettings.Properties)
-	}
-
 	// Map response body to schema and populate with attribute values
-	plan.WebhooksCompany = webhooksCompanyModel{
-		ID:                              types.StringPointerValue(webhookCompanyCreateResponse.Id),
-		Description:                     types.StringPointerValue(webhookCompanyCreateResponse.Description),
-		Type:                            types.StringValue(webhookCompanyCreateResponse.Type),
-		URL:                             types.StringValue(webhookCompanyCreateResponse.Url),
-		Username:                        types.StringPointerValue(webhookCompanyCreateResponse.Username),
-		HasPassword:                     types.BoolPointerValue(webhookCompanyCreateResponse.HasPassword),
-		Active:                          types.BoolValue(webhookCompanyCreateResponse.Active),
-		HasError:                        types.BoolPointerValue(webhookCompanyCreateResponse.HasError),
-		EncryptionProtocol:              types.StringPointerValue(webhookCompanyCreateResponse.EncryptionProtocol),
-		CommunicationFormat:             types.StringValue(webhookCompanyCreateResponse.CommunicationFormat),
-		AcceptsExpiredCertificate:       types.BoolPointerValue(webhookCompanyCreateResponse.AcceptsExpiredCertificate),
-		AcceptsSelfSignedCertificate:    types.BoolPointerValue(webhookCompanyCreateResponse.AcceptsSelfSignedCertificate),
-		AcceptsUntrustedRootCertificate: types.BoolPointerValue(webhookCompanyCreateResponse.AcceptsUntrustedRootCertificate),
-		PopulateSoapActionHeader:        types.BoolPointerValue(webhookCompanyCreateResponse.PopulateSoapActionHeader),
-		CertificateAlias:                types.StringPointerValue(webhookCompanyCreateResponse.CertificateAlias),
-		Password:                        types.StringPointerValue(createCompanyWebhookRequest.Password), //FIXME: figure out how to hide this / or if not needed to hide
-		Links: types.ObjectValueMust(linksAttributeMapCompany, mapWebhooksLinksCompany(
-			webhookCompanyCreateResponse.Links.Self.Href,
-			webhookCompanyCreateResponse.Links.GenerateHmac.Href,
-			webhookCompanyCreateResponse.Links.Company.Href,
-			webhookCompanyCreateResponse.Links.TestWebhook.Href),
-		),
-		AdditionalSettings: types.ObjectValueMust(additionalSettingsAttributeMap, map[string]attr.Value{
-			"include_event_codes": types.ListValueMust(types.StringType, includeEventCodes),
-			"exclude_event_codes": types.ListValueMust(types.StringType, excludeEventCodes),
-			"properties":          types.MapValueMust(types.BoolType, properties),
-		}),
-		FilterMerchantAccountType: types.StringPointerValue(webhookCompanyCreateResponse.FilterMerchantAccountType),
-		FilterMerchantAccounts:    types.ListValueMust(types.StringType, mapWebhooksCompanyFilterMerchantAccountsFromString(webhookCompanyCreateResponse.FilterMerchantAccounts)),
-	}
+	plan.WebhooksCompany = mapWebhooksCompanyModel(webhookCompanyCreateResponse)
+	plan.WebhooksCompany.Password = types.StringPointerValue(createCompanyWebhookRequest.Password) //FIXME: figure out how to hide this / or if not needed to hide
 
 	// Set state with the fully populated webhookCompanyCreateResponse
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	configuredAdditionalSettings, diags := configuredWebhookAdditionalSettingsPrivateState(ctx, req.Config, path.Root("webhooks_company"))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, webhookAdditionalSettingsConfiguredKey, configuredAdditionalSettings)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -367,65 +438,27 @@ func (r *webhookCompanyResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	companyAccount := strings.Trim(state.CompanyAccount, "\"")
-
-	var data management.WebhooksCompanyLevelApiGetWebhookInput
-	if companyAccount != "" && state.WebhooksCompany.ID.ValueString() != "" {
-		data = r.client.Management().WebhooksCompanyLevelApi.GetWebhookInput(companyAccount, state.WebhooksCompany.ID.ValueString())
-	}
-
-	webhookCompanyGetRequest, _, _ := r.client.Management().WebhooksCompanyLevelApi.GetWebhook(ctx, data)
-	_, ok := webhookCompanyGetRequest.GetIdOk()
-	//TODO: check this state logic once over to check edgecases
-	if !ok && state.WebhooksCompany.ID.ValueString() != "" {
+	data := r.client.Management().WebhooksCompanyLevelApi.GetWebhookInput(state.CompanyAccount, state.WebhooksCompany.ID.ValueString())
+	webhookCompanyGetRequest, httpRes, err := r.client.Management().WebhooksCompanyLevelApi.GetWebhook(ctx, data)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the webhook does not exist.
 		resp.State.RemoveResource(ctx)
 		return
 	}
-
-	includeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookCompanyGetRequest.AdditionalSettings.IncludeEventCodes)
-	excludeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookCompanyGetRequest.AdditionalSettings.ExcludeEventCodes)
-	var properties map[string]attr.Value
-	if webhookCompanyGetRequest.AdditionalSettings.Properties != nil {
-		properties = mapWebhooksAdditionalSettingsProperties(*webhookCompanyGetRequest.AdditionalSettings.Properties)
-	}
-
-	var filterMerchantAccounts []attr.Value
-	if len(webhookCompanyGetRequest.FilterMerchantAccounts) > 0 {
-		filterMerchantAccounts = mapWebhooksCompanyFilterMerchantAccountsFromString(webhookCompanyGetRequest.FilterMerchantAccounts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webhooks Company",
+			"Could not read company webhook "+state.WebhooksCompany.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
 	}
 
+	// Adyen never returns the password, so keep the one from the prior state.
+	password := state.WebhooksCompany.Password
 	state = webhooksCompanyResourceModel{
-		companyAccount,
-		webhooksCompanyModel{
-			ID:                              types.StringPointerValue(webhookCompanyGetRequest.Id),
-			Type:                            types.StringValue(webhookCompanyGetRequest.Type),
-			URL:                             types.StringValue(webhookCompanyGetRequest.Url),
-			Username:                        types.StringPointerValue(webhookCompanyGetRequest.Username),
-			HasPassword:                     types.BoolPointerValue(webhookCompanyGetRequest.HasPassword),
-			Active:                          types.BoolValue(webhookCompanyGetRequest.Active),
-			HasError:                        types.BoolPointerValue(webhookCompanyGetRequest.HasError),
-			EncryptionProtocol:              types.StringPointerValue(webhookCompanyGetRequest.EncryptionProtocol),
-			CommunicationFormat:             types.StringValue(webhookCompanyGetRequest.CommunicationFormat),
-			AcceptsExpiredCertificate:       types.BoolPointerValue(webhookCompanyGetRequest.AcceptsExpiredCertificate),
-			AcceptsSelfSignedCertificate:    types.BoolPointerValue(webhookCompanyGetRequest.AcceptsSelfSignedCertificate),
-			AcceptsUntrustedRootCertificate: types.BoolPointerValue(webhookCompanyGetRequest.AcceptsUntrustedRootCertificate),
-			PopulateSoapActionHeader:        types.BoolPointerValue(webhookCompanyGetRequest.PopulateSoapActionHeader),
-			CertificateAlias:                types.StringPointerValue(webhookCompanyGetRequest.CertificateAlias),
-			Links: types.ObjectValueMust(linksAttributeMapCompany, mapWebhooksLinksCompany(
-				webhookCompanyGetRequest.Links.Self.Href,
-				webhookCompanyGetRequest.Links.GenerateHmac.Href,
-				webhookCompanyGetRequest.Links.Company.Href,
-				webhookCompanyGetRequest.Links.TestWebhook.Href),
-			),
-			AdditionalSettings: types.ObjectValueMust(additionalSettingsAttributeMap, map[string]attr.Value{
-				"include_event_codes": types.ListValueMust(types.StringType, includeEventCodes),
-				"exclude_event_codes": types.ListValueMust(types.StringType, excludeEventCodes),
-				"properties":          types.MapValueMust(types.BoolType, properties),
-			}),
-			FilterMerchantAccountType: types.StringPointerValue(webhookCompanyGetRequest.FilterMerchantAccountType),
-			FilterMerchantAccounts:    types.ListValueMust(types.StringType, filterMerchantAccounts),
-		},
+		state.CompanyAccount,
+		mapWebhooksCompanyModel(webhookCompanyGetRequest),
 	}
+	state.WebhooksCompany.Password = password
 
 	tflog.Debug(ctx, "Reading company webhook...")
 
@@ -460,15 +493,23 @@ func (r *webhookCompanyResource) Update(ctx context.Context, req resource.Update
 		PopulateSoapActionHeader:        plan.WebhooksCompany.PopulateSoapActionHeader.ValueBoolPointer(),
 		Url:                             plan.WebhooksCompany.URL.ValueStringPointer(),
 		Username:                        plan.WebhooksCompany.Username.ValueStringPointer(),
+		FilterMerchantAccountType:       plan.WebhooksCompany.FilterMerchantAccountType.ValueStringPointer(),
+		FilterMerchantAccounts:          mapWebhooksCompanyFilterMerchantAccountsToString(plan.WebhooksCompany.FilterMerchantAccounts),
+		EncryptionProtocol:              knownStringPointer(plan.WebhooksCompany.EncryptionProtocol),
 	}
 
-	companyAccount := strings.Trim(plan.CompanyAccount, "\"")
+	additionalSettings, diags := mapWebhooksAdditionalSettingsRequest(ctx, plan.WebhooksCompany.AdditionalSettings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateCompanyWebhookRequest.AdditionalSettings = additionalSettings
 
 	// Create a new webhook
 	webhookCompanyUpdateRequest := r.client.
 		Management().
 		WebhooksCompanyLevelApi.
-		UpdateWebhookInput(companyAccount, plan.WebhooksCompany.ID.ValueString()).
+		UpdateWebhookInput(plan.CompanyAccount, plan.WebhooksCompany.ID.ValueString()).
 		UpdateCompanyWebhookRequest(*updateCompanyWebhookRequest)
 	webhookCompanyUpdateResponse, _, err := r.client.
 		Management().
@@ -482,46 +523,16 @@ func (r *webhookCompanyResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	includeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookCompanyUpdateResponse.AdditionalSettings.IncludeEventCodes)
-	excludeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookCompanyUpdateResponse.AdditionalSettings.ExcludeEventCodes)
-	var properties map[string]attr.Value
-	if webhookCompanyUpdateResponse.AdditionalSettings.Properties != nil {
-		properties = mapWebhooksAdditionalSettingsProperties(*webhookCompanyUpdateResponse.AdditionalSettings.Properties)
-	}
-
 	// Map response body to schema and populate Computed attribute values
-	plan.WebhooksCompany = webhooksCompanyModel{
-		ID:                              types.StringPointerValue(webhookCompanyUpdateResponse.Id),
-		Type:                            types.StringValue(webhookCompanyUpdateResponse.Type),
-		URL:                             types.StringValue(webhookCompanyUpdateResponse.Url),
-		Username:                        types.StringPointerValue(webhookCompanyUpdateResponse.Username),
-		HasPassword:                     types.BoolPointerValue(webhookCompanyUpdateResponse.HasPassword),
-		Active:                          types.BoolValue(webhookCompanyUpdateResponse.Active),
-		HasError:                        types.BoolPointerValue(webhookCompanyUpdateResponse.HasError),
-		EncryptionProtocol:              types.StringPointerValue(webhookCompanyUpdateResponse.EncryptionProtocol),
-		CommunicationFormat:             types.StringValue(webhookCompanyUpdateResponse.CommunicationFormat),
-		AcceptsExpiredCertificate:       types.BoolPointerValue(webhookCompanyUpdateResponse.AcceptsExpiredCertificate),
-		AcceptsSelfSignedCertificate:    types.BoolPointerValue(webhookCompanyUpdateResponse.AcceptsSelfSignedCertificate),
-		AcceptsUntrustedRootCertificate: types.BoolPointerValue(webhookCompanyUpdateResponse.AcceptsUntrustedRootCertificate),
-		PopulateSoapActionHeader:        types.BoolPointerValue(webhookCompanyUpdateResponse.PopulateSoapActionHeader),
-		CertificateAlias:                types.StringPointerValue(webhookCompanyUpdateResponse.CertificateAlias),
-		Password:                        types.StringPointerValue(updateCompanyWebhookRequest.Password), //FIXME
-		Links: types.ObjectValueMust(linksAttributeMapCompany, mapWebhooksLinksCompany(
-			webhookCompanyUpdateResponse.Links.Self.Href,
-			webhookCompanyUpdateResponse.Links.GenerateHmac.Href,
-			webhookCompanyUpdateResponse.Links.Company.Href,
-			webhookCompanyUpdateResponse.Links.TestWebhook.Href),
-		),
-		AdditionalSettings: types.ObjectValueMust(additionalSettingsAttributeMap, map[string]attr.Value{
-			"include_event_codes": types.ListValueMust(types.StringType, includeEventCodes),
-			"exclude_event_codes": types.ListValueMust(types.StringType, excludeEventCodes),
-			"properties":          types.MapValueMust(types.BoolType, properties),
-		}),
-	}
+	plan.WebhooksCompany = mapWebhooksCompanyModel(webhookCompanyUpdateResponse)
+	plan.WebhooksCompany.Password = types.StringPointerValue(updateCompanyWebhookRequest.Password) //FIXME
 
 	// Set state with the fully populated webhookCreateRequest
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	configuredAdditionalSettings, diags := configuredWebhookAdditionalSettingsPrivateState(ctx, req.Config, path.Root("webhooks_company"))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, webhookAdditionalSettingsConfiguredKey, configuredAdditionalSettings)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -536,10 +547,7 @@ func (r *webhookCompanyResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	companyAccount := state.CompanyAccount
-	companyAccount = strings.Trim(companyAccount, "\"") //TODO: figure out why this is necessary
-
-	removeWebhookInput := r.client.Management().WebhooksCompanyLevelApi.RemoveWebhookInput(companyAccount, state.WebhooksCompany.ID.ValueString())
+	removeWebhookInput := r.client.Management().WebhooksCompanyLevelApi.RemoveWebhookInput(state.CompanyAccount, state.WebhooksCompany.ID.ValueString())
 	_, err := r.client.Management().WebhooksCompanyLevelApi.RemoveWebhook(ctx, removeWebhookInput)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -550,7 +558,18 @@ func (r *webhookCompanyResource) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+// ImportState imports an existing webhook using an identifier of the form "<company_account>/<webhook_id>".
 func (r *webhookCompanyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	companyAccount, webhookID, err := splitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import identifier of the form '<company_account>/<webhook_id>': "+err.Error(),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the company_account and nested id attributes, Read fills in the remaining state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("company_account"), companyAccount)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("webhooks_company").AtName("id"), webhookID)...)
 }
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -44,8 +47,7 @@ func TestAccWebhookCompanyResource(t *testing.T) {
 		},
 		Steps: []resource.TestStep{
 			{
-				Config:             testProviderClientFromTmpl(t) + testConfigCreateCompanyWebhook(),
-				ExpectNonEmptyPlan: true, // Creating a tf resource will propose changes, that's why this value is set to 'true'. Can be approached differently by using `PlanOnly: true`.
+				Config: testProviderClientFromTmpl(t) + testConfigCreateCompanyWebhook(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "webhooks_company.type", "standard"),
 					resource.TestCheckResourceAttr(resourceName, "webhooks_company.url", "https://webhook.site/cb798fb3-7092-4cab-986b-f416fb04f92e"),
@@ -63,6 +65,103 @@ func TestAccWebhookCompanyResource(t *testing.T) {
 						resourceName, "webhooks_company.filter_merchant_accounts.*", "WeaveAccountECOM"),
 				),
 			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "webhooks_company.id",
+				ImportStateVerifyIgnore:              []string{"webhooks_company.password"}, // Adyen never returns the password of a webhook.
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+					return rs.Primary.Attributes["company_account"] + "/" + rs.Primary.Attributes["webhooks_company.id"], nil
+				},
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigUpdateCompanyWebhook("standard"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "webhooks_company.filter_merchant_account_type", "excludeAccounts"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks_company.filter_merchant_accounts.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks_company.filter_merchant_accounts.0", "WeaveAccountECOM"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks_company.filter_merchant_accounts.1", "WeaveAccountPOS"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks_company.encryption_protocol", "TLSv1.3"),
+				),
+			},
+			{
+				// Switching to all merchant accounts must clear the merchant accounts stored by Adyen.
+				Config: testProviderClientFromTmpl(t) + strings.NewReplacer(
+					`"excludeAccounts"`, `"allAccounts"`,
+					`["WeaveAccountECOM", "WeaveAccountPOS"]`, `[]`,
+				).Replace(testConfigUpdateCompanyWebhook("standard")),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "webhooks_company.filter_merchant_account_type", "allAccounts"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks_company.filter_merchant_accounts.#", "0"),
+				),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigUpdateCompanyWebhook("report-notification"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "webhooks_company.type", "report-notification"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWebhookCompanyResourceValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testProviderClientFromTmpl(t) + testConfigValidateCompanyWebhook("standard", "json", "allAccounts", `["WeaveAccountECOM"]`, false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`filter_merchant_accounts must be empty if filter_merchant_account_type is\s+allAccounts`),
+			},
+			{
+				Config:      testProviderClientFromTmpl(t) + testConfigValidateCompanyWebhook("standard", "json", "includeAccounts", `[]`, false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`filter_merchant_accounts must not be empty`),
+			},
+			{
+				Config:      testProviderClientFromTmpl(t) + testConfigValidateCompanyWebhook("standard", "json", "includeAccounts", `["WeaveAccountECOM"]`, true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`populate_soap_action_header only applies to the soap communication format`),
+			},
+			{
+				Config:      testProviderClientFromTmpl(t) + testConfigValidateCompanyWebhook("unknown-notification", "json", "includeAccounts", `["WeaveAccountECOM"]`, false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			{
+				Config:      testProviderClientFromTmpl(t) + testConfigValidateCompanyWebhook("standard", "xml", "includeAccounts", `["WeaveAccountECOM"]`, false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			{
+				Config:      testProviderClientFromTmpl(t) + testConfigValidateCompanyWebhook("standard", "json", "someAccounts", `["WeaveAccountECOM"]`, false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
 		},
 	})
 }
@@ -88,3 +187,46 @@ func testConfigCreateCompanyWebhook() string {
 	}
 `
 }
+
+func testConfigUpdateCompanyWebhook(webhookType string) string {
+	return fmt.Sprintf(`
+	resource "adyen_webhooks_company" "test" {
+		company_account  = "WeaveAccount"
+		webhooks_company = {
+			type                               = "%s"
+			password                           = "secretpassword"
+			url                                = "https://webhook.site/cb798fb3-7092-4cab-986b-f416fb04f92e"
+			username                           = "provider_tf"
+			active                             = true
+			communication_format               = "http"
+			encryption_protocol                = "TLSv1.3"
+			accepts_expired_certificate        = false
+			accepts_self_signed_certificate    = true
+			accepts_untrusted_root_certificate = true
+			populate_soap_action_header        = false
+			filter_merchant_account_type       = "excludeAccounts"
+			filter_merchant_accounts           = ["WeaveAccountECOM", "WeaveAccountPOS"]
+		}
+	}
+`, webhookType)
+}
+
+func testConfigValidateCompanyWebhook(webhookType string, communicationFormat string, filterMerchantAccountType string, filterMerchantAccounts string, populateSoapActionHeader bool) string {
+	return fmt.Sprintf(`
+	resource "adyen_webhooks_company" "validate" {
+		company_account  = "WeaveAccount"
+		webhooks_company = {
+			type                               = "%s"
+			url                                = "https://webhook.site/cb798fb3-7092-4cab-986b-f416fb04f92e"
+			active                             = true
+			communication_format               = "%s"
+			accepts_expired_certificate        = false
+			accepts_self_signed_certificate    = true
+			accepts_untrusted_root_certificate = true
+			populate_soap_action_header        = %t
+			filter_merchant_account_type       = "%s"
+			filter_merchant_accounts           = %s
+		}
+	}
+`, webhookType, communicationFormat, populateSoapActionHeader, filterMerchantAccountType, filterMerchantAccounts)
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &webhookHmacKeyResource{}
+	_ resource.ResourceWithConfigure = &webhookHmacKeyResource{}
+)
+
+// webhookHmacKeyResource generates the HMAC key Adyen signs the notifications of a merchant or company webhook with.
+// Adyen only returns the key when it is generated, so it is kept in the Terraform state.
+type webhookHmacKeyResource struct {
+	client *adyen.APIClient
+}
+
+// NewWebhookHmacKeyResource is a helper function to simplify the provider implementation.
+func NewWebhookHmacKeyResource() resource.Resource {
+	return &webhookHmacKeyResource{}
+}
+
+// webhookHmacKeyResourceModel maps the "webhook_hmac_key" schema data for a resource.
+type webhookHmacKeyResourceModel struct {
+	WebhookID       types.String `tfsdk:"webhook_id"`
+	CompanyAccount  types.String `tfsdk:"company_account"`
+	RotationTrigger types.Map    `tfsdk:"rotation_trigger"`
+	HmacKey         types.String `tfsdk:"hmac_key"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webhookHmacKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *webhookHmacKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_hmac_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *webhookHmacKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates an HMAC key for a merchant or company webhook. By creating an HMAC key, you start receiving HMAC-signed notifications from Adyen.\n\n" +
+			"Generating a new key invalidates the previous one. Change rotation_trigger to rotate the key.\n\n" +
+			"To make this request, your API credential must have the following roles:\n\nManagement API—Webhooks read and write",
+		Attributes: map[string]schema.Attribute{
+			"webhook_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the webhook to generate the HMAC key for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"company_account": schema.StringAttribute{
+				Optional:    true,
+				Description: "The company account of a company webhook. If not set, the webhook belongs to the merchant account of the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_trigger": rotationTriggerAttribute("HMAC key"),
+			"hmac_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The HMAC key generated for the webhook.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create generates a new HMAC key and sets the initial Terraform state.
+func (r *webhookHmacKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Generating adyen webhook HMAC key")
+
+	// Retrieve values from the plan
+	var plan webhookHmacKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var generateHmacKeyResponse management.GenerateHmacKeyResponse
+	var err error
+	if plan.CompanyAccount.ValueString() != "" {
+		generateHmacKeyInput := r.client.Management().WebhooksCompanyLevelApi.GenerateHmacKeyInput(plan.CompanyAccount.ValueString(), plan.WebhookID.ValueString())
+		generateHmacKeyResponse, _, err = r.client.Management().WebhooksCompanyLevelApi.GenerateHmacKey(ctx, generateHmacKeyInput)
+	} else {
+		generateHmacKeyInput := r.client.Management().WebhooksMerchantLevelApi.GenerateHmacKeyInput(r.client.GetConfig().MerchantAccount, plan.WebhookID.ValueString())
+		generateHmacKeyResponse, _, err = r.client.Management().WebhooksMerchantLevelApi.GenerateHmacKey(ctx, generateHmacKeyInput)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error generating webhook HMAC key",
+			"Could not generate HMAC key for webhook "+plan.WebhookID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.HmacKey = types.StringValue(generateHmacKeyResponse.HmacKey)
+
+	// Set state with the generated HMAC key
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read removes the HMAC key from state once its webhook is deleted. Adyen does not return HMAC keys, so otherwise the key is kept as is.
+func (r *webhookHmacKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webhookHmacKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if state.CompanyAccount.ValueString() != "" {
+		getWebhookInput := r.client.Management().WebhooksCompanyLevelApi.GetWebhookInput(state.CompanyAccount.ValueString(), state.WebhookID.ValueString())
+		_, httpRes, err = r.client.Management().WebhooksCompanyLevelApi.GetWebhook(ctx, getWebhookInput)
+	} else {
+		getWebhookInput := r.client.Management().WebhooksMerchantLevelApi.GetWebhookInput(r.client.GetConfig().MerchantAccount, state.WebhookID.ValueString())
+		_, httpRes, err = r.client.Management().WebhooksMerchantLevelApi.GetWebhook(ctx, getWebhookInput)
+	}
+	if !checkGeneratedKeyOwner(ctx, resp, httpRes, err, "Error Reading Webhook HMAC Key", "webhook "+state.WebhookID.ValueString()) {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading webhook HMAC key...")
+}
+
+// Update only stores the planned values, as every attribute that affects the HMAC key requires a replacement.
+func (r *webhookHmacKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webhookHmacKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the HMAC key from the Terraform state. The webhook keeps signing its notifications with the key until a new one is generated.
+func (r *webhookHmacKeyResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	deleteGeneratedKey(ctx, "webhook HMAC key")
+}
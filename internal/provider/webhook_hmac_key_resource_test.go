@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccWebhookHmacKeyResource(t *testing.T) {
+	resourceName := "adyen_webhook_hmac_key.test"
+	var hmacKey string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenWebhookMerchantDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigCreateMerchantWebhook() + testConfigWebhookHmacKey("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "webhook_id", "adyen_webhooks_merchant.test", "webhooks_merchant.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "hmac_key"),
+					testAccCheckRotatedKey(resourceName, "hmac_key", &hmacKey, false),
+				),
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigCreateMerchantWebhook() + testConfigWebhookHmacKey("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_trigger.version", "2"),
+					testAccCheckRotatedKey(resourceName, "hmac_key", &hmacKey, true),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckRotatedKey stores the key attribute of the resource in key, checking if it was rotated since the previous step when expectRotated is set.
+func testAccCheckRotatedKey(resourceName string, attribute string, key *string, expectRotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		current := rs.Primary.Attributes[attribute]
+		if expectRotated && current == *key {
+			return fmt.Errorf("expected %s of %s to be rotated, got the previous key", attribute, resourceName)
+		}
+		*key = current
+		return nil
+	}
+}
+
+func testConfigWebhookHmacKey(version string) string {
+	return fmt.Sprintf(`
+	resource "adyen_webhook_hmac_key" "test" {
+		webhook_id = adyen_webhooks_merchant.test.webhooks_merchant.id
+		rotation_trigger = {
+			version = "%s"
+		}
+	}
+`, version)
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &webhookMerchantDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookMerchantDataSource{}
+)
+
+// webhookMerchantDataSource is the data source implementation.
+type webhookMerchantDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewWebhooksMerchantDataSource is a helper function to simplify the provider implementation.
+func NewWebhooksMerchantDataSource() datasource.DataSource {
+	return &webhookMerchantDataSource{}
+}
+
+// webhooksMerchantDataSourceModel maps the "webhooks_merchant" schema data for a data source.
+type webhooksMerchantDataSourceModel struct {
+	MerchantAccount  types.String                            `tfsdk:"merchant_account"`
+	ID               types.String                            `tfsdk:"id"`
+	WebhooksMerchant *webhooksMerchantDataSourceWebhookModel `tfsdk:"webhooks_merchant"`
+}
+
+// webhooksMerchantDataSourceWebhookModel maps a merchant webhook for the data sources. Adyen never returns the password of a webhook, so unlike the resource it has none.
+type webhooksMerchantDataSourceWebhookModel struct {
+	ID                              types.String `tfsdk:"id"`
+	Type                            types.String `tfsdk:"type"`
+	URL                             types.String `tfsdk:"url"`
+	Username                        types.String `tfsdk:"username"`
+	Description                     types.String `tfsdk:"description"`
+	HasPassword                     types.Bool   `tfsdk:"has_password"`
+	Active                          types.Bool   `tfsdk:"active"`
+	HasError                        types.Bool   `tfsdk:"has_error"`
+	EncryptionProtocol              types.String `tfsdk:"encryption_protocol"`
+	CommunicationFormat             types.String `tfsdk:"communication_format"`
+	AcceptsExpiredCertificate       types.Bool   `tfsdk:"accepts_expired_certificate"`
+	AcceptsSelfSignedCertificate    types.Bool   `tfsdk:"accepts_self_signed_certificate"`
+	AcceptsUntrustedRootCertificate types.Bool   `tfsdk:"accepts_untrusted_root_certificate"`
+	CertificateAlias                types.String `tfsdk:"certificate_alias"`
+	PopulateSoapActionHeader        types.Bool   `tfsdk:"populate_soap_action_header"`
+	Links                           types.Object `tfsdk:"links"`
+	AdditionalSettings              types.Object `tfsdk:"additional_settings"`
+}
+
+// mapWebhooksMerchantDataSourceWebhookModel maps a merchant webhook returned by the Adyen API to its data source model.
+func mapWebhooksMerchantDataSourceWebhookModel(webhook management.Webhook) webhooksMerchantDataSourceWebhookModel {
+	model := mapWebhooksMerchantModel(webhook)
+	return webhooksMerchantDataSourceWebhookModel{
+		ID:                              model.ID,
+		Type:                            model.Type,
+		URL:                             model.URL,
+		Username:                        model.Username,
+		Description:                     model.Description,
+		HasPassword:                     model.HasPassword,
+		Active:                          model.Active,
+		HasError:                        model.HasError,
+		EncryptionProtocol:              model.EncryptionProtocol,
+		CommunicationFormat:             model.CommunicationFormat,
+		AcceptsExpiredCertificate:       model.AcceptsExpiredCertificate,
+		AcceptsSelfSignedCertificate:    model.AcceptsSelfSignedCertificate,
+		AcceptsUntrustedRootCertificate: model.AcceptsUntrustedRootCertificate,
+		CertificateAlias:                model.CertificateAlias,
+		PopulateSoapActionHeader:        model.PopulateSoapActionHeader,
+		Links:                           model.Links,
+		AdditionalSettings:              model.AdditionalSettings,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webhookMerchantDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *webhookMerchantDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks_merchant"
+}
+
+// Schema defines the schema for the data source.
+func (d *webhookMerchantDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns a webhook configured for a merchant account.\n\n" +
+			"To make this request, your API credential must have one of the following roles:\n\nManagement API—Webhooks read\nManagement API—Webhooks read and write",
+		Attributes: map[string]schema.Attribute{
+			"merchant_account": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The merchant account the webhook is configured for. Defaults to the merchant account of the provider.",
+			},
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the webhook.",
+			},
+			"webhooks_merchant": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The webhook configuration.",
+				Attributes:  webhooksMerchantDataSourceAttributes(),
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *webhookMerchantDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state webhooksMerchantDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading merchant webhook data source...")
+
+	if state.MerchantAccount.IsNull() {
+		state.MerchantAccount = types.StringValue(d.client.GetConfig().MerchantAccount)
+	}
+
+	getWebhookInput := d.client.Management().WebhooksMerchantLevelApi.GetWebhookInput(state.MerchantAccount.ValueString(), state.ID.ValueString())
+	webhook, _, err := d.client.Management().WebhooksMerchantLevelApi.GetWebhook(ctx, getWebhookInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webhooks Merchant",
+			"Could not read merchant webhook "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	webhooksMerchant := mapWebhooksMerchantDataSourceWebhookModel(webhook)
+	state.WebhooksMerchant = &webhooksMerchant
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// webhooksMerchantDataSourceAttributes returns the computed attributes of a merchant webhook, shared by the merchant webhook data sources.
+func webhooksMerchantDataSourceAttributes() map[string]schema.Attribute {
+	attributes := webhooksDataSourceAttributes()
+	attributes["links"] = schema.SingleNestedAttribute{
+		Computed: true,
+		Attributes: map[string]schema.Attribute{
+			"self":          webhooksDataSourceLinkAttribute("The API URL to the webhook itself."),
+			"generate_hmac": webhooksDataSourceLinkAttribute("The API URL to generate an HMAC key for the webhook."),
+			"merchant":      webhooksDataSourceLinkAttribute("The API URL to the merchant account associated with the webhook."),
+			"test_webhook":  webhooksDataSourceLinkAttribute("The API URL to test the webhook."),
+		},
+	}
+	return attributes
+}
+
+// webhooksDataSourceAttributes returns the computed attributes shared by merchant and company webhooks.
+func webhooksDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier for this webhook.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of webhook.",
+		},
+		"url": schema.StringAttribute{
+			Computed:    true,
+			Description: "Public URL where webhooks will be sent.",
+		},
+		"username": schema.StringAttribute{
+			Computed:    true,
+			Description: "Username to access the webhook URL.",
+		},
+		"has_password": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Indicates if the webhook is password protected.",
+		},
+		"active": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Indicates if the webhook configuration is active.",
+		},
+		"communication_format": schema.StringAttribute{
+			Computed:    true,
+			Description: "Format or protocol for receiving webhooks.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Your description for this webhook configuration.",
+		},
+		"encryption_protocol": schema.StringAttribute{
+			Computed:    true,
+			Description: "SSL version to access the public webhook URL specified in the url field.",
+		},
+		"has_error": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Indicates if the webhook configuration has errors that need troubleshooting.",
+		},
+		"certificate_alias": schema.StringAttribute{
+			Computed:    true,
+			Description: "The alias of Adyen SSL certificate.",
+		},
+		"populate_soap_action_header": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Indicates if the SOAP action header needs to be populated.",
+		},
+		"accepts_expired_certificate": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Indicates if expired SSL certificates are accepted.",
+		},
+		"accepts_self_signed_certificate": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Indicates if self-signed SSL certificates are accepted.",
+		},
+		"accepts_untrusted_root_certificate": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Indicates if untrusted SSL certificates are accepted.",
+		},
+		"additional_settings": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Additional shopper and transaction information included in your standard notifications.",
+			Attributes: map[string]schema.Attribute{
+				"properties": schema.MapAttribute{
+					Computed:    true,
+					ElementType: types.BoolType,
+					Description: "Object containing boolean key-value pairs, indicating which additional settings are enabled.",
+				},
+				"include_event_codes": schema.ListAttribute{
+					Computed:    true,
+					ElementType: types.StringType,
+					Description: "Object containing list of event codes for which the notification will be sent.",
+				},
+				"exclude_event_codes": schema.ListAttribute{
+					Computed:    true,
+					ElementType: types.StringType,
+					Description: "Object containing list of event codes for which the notification will NOT be sent.",
+				},
+			},
+		},
+	}
+}
+
+func webhooksDataSourceLinkAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"href": schema.StringAttribute{Computed: true},
+		},
+		Computed:    true,
+		Description: description,
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccWebhookMerchantDataSource(t *testing.T) {
+	resourceName := "adyen_webhooks_merchant.test"
+	dataSourceName := "data.adyen_webhooks_merchant.test"
+	listDataSourceName := "data.adyen_webhooks_merchant_list.test"
+	merchantAccount := os.Getenv("ADYEN_API_MERCHANT_ACCOUNT")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdyenWebhookMerchantDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigCreateMerchantWebhook() + testConfigMerchantWebhookDataSources(merchantAccount),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "merchant_account", merchantAccount),
+					resource.TestCheckResourceAttrPair(dataSourceName, "webhooks_merchant.id", resourceName, "webhooks_merchant.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks_merchant.type", "standard"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks_merchant.url", "https://webhook.site/test-uuid"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks_merchant.communication_format", "json"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "webhooks_merchant.links.self.href"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "webhooks_merchant.links.test_webhook.href"),
+					resource.TestCheckTypeSetElemNestedAttrs(listDataSourceName, "webhooks_merchant.*", map[string]string{
+						"url":                  "https://webhook.site/test-uuid",
+						"communication_format": "json",
+					}),
+					resource.TestCheckNoResourceAttr(dataSourceName, "webhooks_merchant.password"),
+					resource.TestCheckResourceAttrPair("data.adyen_webhooks_merchant.merchant_account", "webhooks_merchant.id", resourceName, "webhooks_merchant.id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.adyen_webhooks_merchant_list.merchant_account", "webhooks_merchant.*", map[string]string{
+						"url": "https://webhook.site/test-uuid",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testConfigMerchantWebhookDataSources(merchantAccount string) string {
+	return fmt.Sprintf(`
+	data "adyen_webhooks_merchant" "test" {
+		id = adyen_webhooks_merchant.test.webhooks_merchant.id
+	}
+
+	data "adyen_webhooks_merchant_list" "test" {
+		depends_on = [adyen_webhooks_merchant.test]
+	}
+
+	data "adyen_webhooks_merchant" "merchant_account" {
+		merchant_account = "%[1]s"
+		id               = adyen_webhooks_merchant.test.webhooks_merchant.id
+	}
+
+	data "adyen_webhooks_merchant_list" "merchant_account" {
+		merchant_account = "%[1]s"
+		depends_on       = [adyen_webhooks_merchant.test]
+	}
+`, merchantAccount)
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &webhookMerchantListDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookMerchantListDataSource{}
+)
+
+// webhookMerchantListDataSource is the data source implementation.
+type webhookMerchantListDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewWebhooksMerchantListDataSource is a helper function to simplify the provider implementation.
+func NewWebhooksMerchantListDataSource() datasource.DataSource {
+	return &webhookMerchantListDataSource{}
+}
+
+// webhooksMerchantListDataSourceModel maps the "webhooks_merchant_list" schema data for a data source.
+type webhooksMerchantListDataSourceModel struct {
+	MerchantAccount  types.String                             `tfsdk:"merchant_account"`
+	WebhooksMerchant []webhooksMerchantDataSourceWebhookModel `tfsdk:"webhooks_merchant"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webhookMerchantListDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *webhookMerchantListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks_merchant_list"
+}
+
+// Schema defines the schema for the data source.
+func (d *webhookMerchantListDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns all webhooks configured for a merchant account.\n\n" +
+			"To make this request, your API credential must have one of the following roles:\n\nManagement API—Webhooks read\nManagement API—Webhooks read and write",
+		Attributes: map[string]schema.Attribute{
+			"merchant_account": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The merchant account the webhooks are configured for. Defaults to the merchant account of the provider.",
+			},
+			"webhooks_merchant": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The webhook configurations of the merchant account.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: webhooksMerchantDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *webhookMerchantListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state webhooksMerchantListDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading merchant webhooks data source...")
+
+	if state.MerchantAccount.IsNull() {
+		state.MerchantAccount = types.StringValue(d.client.GetConfig().MerchantAccount)
+	}
+	state.WebhooksMerchant = []webhooksMerchantDataSourceWebhookModel{}
+
+	// Adyen returns at most 100 webhooks per page, so keep fetching until all pages are read.
+	for page := int32(1); ; page++ {
+		listWebhooksInput := d.client.Management().WebhooksMerchantLevelApi.
+			ListAllWebhooksInput(state.MerchantAccount.ValueString()).
+			PageNumber(page).
+			PageSize(listPageSize)
+		listWebhooksResponse, _, err := d.client.Management().WebhooksMerchantLevelApi.ListAllWebhooks(ctx, listWebhooksInput)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Webhooks Merchant",
+				"Could not list merchant webhooks, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		for _, webhook := range listWebhooksResponse.Data {
+			state.WebhooksMerchant = append(state.WebhooksMerchant, mapWebhooksMerchantDataSourceWebhookModel(webhook))
+		}
+
+		if page >= listWebhooksResponse.PagesTotal {
+			break
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -5,20 +5,29 @@ import (
 	"fmt"
 	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
 	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &webhookMerchantResource{}
-	_ resource.ResourceWithConfigure = &webhookMerchantResource{}
+	_ resource.Resource                     = &webhookMerchantResource{}
+	_ resource.ResourceWithConfigure        = &webhookMerchantResource{}
+	_ resource.ResourceWithImportState      = &webhookMerchantResource{}
+	_ resource.ResourceWithConfigValidators = &webhookMerchantResource{}
+	_ resource.ResourceWithModifyPlan       = &webhookMerchantResource{}
 )
 
 // webhookResource is the resource implementation.
@@ -57,6 +66,50 @@ type webhooksMerchantModel struct {
 	AdditionalSettings              types.Object `tfsdk:"additional_settings"`
 }
 
+// mapWebhooksMerchantModel maps a merchant webhook returned by the Adyen API to its Terraform model.
+// Adyen never returns the password of a webhook, so it is left for the caller to fill in.
+func mapWebhooksMerchantModel(webhook management.Webhook) webhooksMerchantModel {
+	links := webhook.GetLinks()
+	additionalSettings := webhook.GetAdditionalSettings()
+
+	includeEventCodes := mapWebhooksAdditionalSettingsEventCodes(additionalSettings.IncludeEventCodes)
+	excludeEventCodes := mapWebhooksAdditionalSettingsEventCodes(additionalSettings.ExcludeEventCodes)
+	var properties map[string]attr.Value
+	if additionalSettings.Properties != nil {
+		properties = mapWebhooksAdditionalSettingsProperties(*additionalSettings.Properties)
+	}
+
+	return webhooksMerchantModel{
+		ID:                              types.StringPointerValue(webhook.Id),
+		Description:                     types.StringPointerValue(webhook.Description),
+		Type:                            types.StringValue(webhook.Type),
+		URL:                             types.StringValue(webhook.Url),
+		Username:                        types.StringPointerValue(webhook.Username),
+		HasPassword:                     types.BoolPointerValue(webhook.HasPassword),
+		Password:                        types.StringNull(),
+		Active:                          types.BoolValue(webhook.Active),
+		HasError:                        types.BoolPointerValue(webhook.HasError),
+		EncryptionProtocol:              types.StringPointerValue(webhook.EncryptionProtocol),
+		CommunicationFormat:             types.StringValue(webhook.CommunicationFormat),
+		AcceptsExpiredCertificate:       types.BoolPointerValue(webhook.AcceptsExpiredCertificate),
+		AcceptsSelfSignedCertificate:    types.BoolPointerValue(webhook.AcceptsSelfSignedCertificate),
+		AcceptsUntrustedRootCertificate: types.BoolPointerValue(webhook.AcceptsUntrustedRootCertificate),
+		PopulateSoapActionHeader:        types.BoolPointerValue(webhook.PopulateSoapActionHeader),
+		CertificateAlias:                types.StringPointerValue(webhook.CertificateAlias),
+		Links: types.ObjectValueMust(linksAttributeMapMerchant, mapWebhooksLinksMerchant(
+			links.Self.Href,
+			links.GenerateHmac.Href,
+			links.GetMerchant().Href,
+			links.TestWebhook.Href),
+		),
+		AdditionalSettings: types.ObjectValueMust(additionalSettingsAttributeMap, map[string]attr.Value{
+			"include_event_codes": types.ListValueMust(types.StringType, includeEventCodes),
+			"exclude_event_codes": types.ListValueMust(types.StringType, excludeEventCodes),
+			"properties":          types.MapValueMust(types.BoolType, properties),
+		}),
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *webhookMerchantResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -104,7 +157,14 @@ func (r *webhookMerchantResource) Schema(ctx context.Context, req resource.Schem
 						Description: "The type of webhook that is being created. Possible values are:\n\nstandard\naccount-settings-notification\n" +
 							"banktransfer-notification\nboletobancario-notification\ndirectdebit-notification\nach-notification-of-change-notification\n" +
 							"pending-notification\nideal-notification\nideal-pending-notification\nreport-notification\nrreq-notification\n" +
-							"Find out more about standard notification webhooks and other types of notifications.",
+							"Find out more about standard notification webhooks and other types of notifications.\n" +
+							"Adyen does not allow changing the type of an existing webhook, so changing it forces a new webhook to be created.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(webhookTypes...),
+						},
 					},
 					"url": schema.StringAttribute{
 						Required:    true,
@@ -130,6 +190,9 @@ func (r *webhookMerchantResource) Schema(ctx context.Context, req resource.Schem
 					"communication_format": schema.StringAttribute{
 						Required:    true,
 						Description: "Format or protocol for receiving webhooks. Possible values:\n\nsoap\nhttp\njson",
+						Validators: []validator.String{
+							stringvalidator.OneOf(webhookCommunicationFormats...),
+						},
 					},
 					"description": schema.StringAttribute{
 						Computed:    true,
@@ -141,6 +204,12 @@ func (r *webhookMerchantResource) Schema(ctx context.Context, req resource.Schem
 						Description: "SSL version to access the public webhook URL specified in the url field. " +
 							"Possible values:\n\nTLSv1.3\nTLSv1.2\n & HTTP. HTTP is Only allowed on Test environment.\n" +
 							"If not specified, the webhook will use sslVersion: TLSv1.2.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(webhookEncryptionProtocols...),
+						},
 					},
 					"has_error": schema.BoolAttribute{
 						Computed:    true,
@@ -201,26 +270,44 @@ func (r *webhookMerchantResource) Schema(ctx context.Context, req resource.Schem
 						},
 					},
 					"additional_settings": schema.SingleNestedAttribute{
-						Computed:    true,
-						Description: "Additional shopper and transaction information to be included in your standard notifications.",
+						Optional: true,
+						Computed: true,
+						Description: "Additional shopper and transaction information to be included in your standard notifications. " +
+							"Removing properties or include_event_codes from the configuration clears them, " +
+							"while settings that were never configured, for example of an imported webhook, keep the values set in Adyen.",
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
 						Attributes: map[string]schema.Attribute{
 							"properties": schema.MapAttribute{
+								Optional:    true,
 								Computed:    true,
 								ElementType: types.BoolType,
 								Description: "Object containing boolean key-value pairs. " +
 									"The key can be any standard webhook additional setting, and the value indicates if the setting is enabled. " +
 									"For example, captureDelayHours: true means the standard notifications you get will contain the " +
 									"number of hours remaining until the payment will be captured.",
+								PlanModifiers: []planmodifier.Map{
+									mapplanmodifier.UseStateForUnknown(),
+								},
 							},
 							"include_event_codes": schema.ListAttribute{
+								Optional:    true,
 								Computed:    true,
 								ElementType: types.StringType,
 								Description: "Object containing list of event codes for which the notification will be sent.",
+								PlanModifiers: []planmodifier.List{
+									listplanmodifier.UseStateForUnknown(),
+								},
 							},
 							"exclude_event_codes": schema.ListAttribute{
 								Computed:    true,
 								ElementType: types.StringType,
-								Description: "Object containing list of event codes for which the notification will NOT be sent.",
+								Description: "Object containing list of event codes for which the notification will NOT be sent. " +
+									"Read-only, as the Adyen API does not accept excluded event codes when creating or updating a webhook.",
+								PlanModifiers: []planmodifier.List{
+									listplanmodifier.UseStateForUnknown(),
+								},
 							},
 						},
 					},
@@ -230,6 +317,18 @@ func (r *webhookMerchantResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+// ConfigValidators returns the validations that span multiple attributes of the webhook.
+func (r *webhookMerchantResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		webhookPopulateSoapActionHeaderValidator{webhookPath: path.Root("webhooks_merchant")},
+	}
+}
+
+// ModifyPlan clears the additional settings that are removed from the configuration.
+func (r *webhookMerchantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWebhookAdditionalSettingsPlan(ctx, req, resp, path.Root("webhooks_merchant"))
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *webhookMerchantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "Creating adyen merchant webhook")
@@ -254,8 +353,16 @@ func (r *webhookMerchantResource) Create(ctx context.Context, req resource.Creat
 		Type:                            plan.WebhooksMerchant.Type.ValueString(),
 		Url:                             plan.WebhooksMerchant.URL.ValueString(),
 		Username:                        plan.WebhooksMerchant.Username.ValueStringPointer(),
+		EncryptionProtocol:              knownStringPointer(plan.WebhooksMerchant.EncryptionProtocol),
 	}
 
+	additionalSettings, diags := mapWebhooksAdditionalSettingsRequest(ctx, plan.WebhooksMerchant.AdditionalSettings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createMerchantWebhookRequest.AdditionalSettings = additionalSettings
+
 	// Create a new webhook
 	webhookMerchantCreateRequest := r.client.
 		Management().
@@ -274,47 +381,16 @@ func (r *webhookMerchantResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	includeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookMerchantCreateResponse.AdditionalSettings.IncludeEventCodes)
-	excludeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookMerchantCreateResponse.AdditionalSettings.ExcludeEventCodes)
-	var properties map[string]attr.Value
-	if webhookMerchantCreateResponse.AdditionalSettings.Properties != nil {
-		properties = mapWebhooksAdditionalSettingsProperties(*webhookMerchantCreateResponse.AdditionalSettings.Properties)
-	}
-
 	// Map response body to schema and populate with attribute values
-	plan.WebhooksMerchant = webhooksMerchantModel{
-		ID:                              types.StringPointerValue(webhookMerchantCreateResponse.Id),
-		Description:                     types.StringPointerValue(webhookMerchantCreateResponse.Description),
-		Type:                            types.StringValue(webhookMerchantCreateResponse.Type),
-		URL:                             types.StringValue(webhookMerchantCreateResponse.Url),
-		Username:                        types.StringPointerValue(webhookMerchantCreateResponse.Username),
-		HasPassword:                     types.BoolPointerValue(webhookMerchantCreateResponse.HasPassword),
-		Active:                          types.BoolValue(webhookMerchantCreateResponse.Active),
-		HasError:                        types.BoolPointerValue(webhookMerchantCreateResponse.HasError),
-		EncryptionProtocol:              types.StringPointerValue(webhookMerchantCreateResponse.EncryptionProtocol),
-		CommunicationFormat:             types.StringValue(webhookMerchantCreateResponse.CommunicationFormat),
-		AcceptsExpiredCertificate:       types.BoolPointerValue(webhookMerchantCreateResponse.AcceptsExpiredCertificate),
-		AcceptsSelfSignedCertificate:    types.BoolPointerValue(webhookMerchantCreateResponse.AcceptsSelfSignedCertificate),
-		AcceptsUntrustedRootCertificate: types.BoolPointerValue(webhookMerchantCreateResponse.AcceptsUntrustedRootCertificate),
-		PopulateSoapActionHeader:        types.BoolPointerValue(webhookMerchantCreateResponse.PopulateSoapActionHeader),
-		CertificateAlias:                types.StringPointerValue(webhookMerchantCreateResponse.CertificateAlias),
-		Password:                        types.StringPointerValue(createMerchantWebhookRequest.Password), //FIXME: figure out how to hide this / or if not needed to hide
-		Links: types.ObjectValueMust(linksAttributeMapMerchant, mapWebhooksLinksMerchant(
-			webhookMerchantCreateResponse.Links.Self.Href,
-			webhookMerchantCreateResponse.Links.GenerateHmac.Href,
-			webhookMerchantCreateResponse.Links.Merchant.Href,
-			webhookMerchantCreateResponse.Links.TestWebhook.Href),
-		),
-		AdditionalSettings: types.ObjectValueMust(additionalSettingsAttributeMap, map[string]attr.Value{
-			"include_event_codes": types.ListValueMust(types.StringType, includeEventCodes),
-			"exclude_event_codes": types.ListValueMust(types.StringType, excludeEventCodes),
-			"properties":          types.MapValueMust(types.BoolType, properties),
-		}),
-	}
+	plan.WebhooksMerchant = mapWebhooksMerchantModel(webhookMerchantCreateResponse)
+	plan.WebhooksMerchant.Password = types.StringPointerValue(createMerchantWebhookRequest.Password) //FIXME: figure out how to hide this / or if not needed to hide
 
 	// Set state with the fully populated webhookMerchantCreateResponse
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	configuredAdditionalSettings, diags := configuredWebhookAdditionalSettingsPrivateState(ctx, req.Config, path.Root("webhooks_merchant"))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, webhookAdditionalSettingsConfiguredKey, configuredAdditionalSettings)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -329,55 +405,26 @@ func (r *webhookMerchantResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	var data management.WebhooksMerchantLevelApiGetWebhookInput
-	if r.client.GetConfig().MerchantAccount != "" && state.WebhooksMerchant.ID.ValueString() != "" {
-		data = r.client.Management().WebhooksMerchantLevelApi.GetWebhookInput(r.client.GetConfig().MerchantAccount, state.WebhooksMerchant.ID.ValueString())
-	}
-
-	webhookMerchantGetRequest, _, _ := r.client.Management().WebhooksMerchantLevelApi.GetWebhook(ctx, data)
-	_, ok := webhookMerchantGetRequest.GetIdOk()
-	//TODO: check this state logic once over to check edgecases
-	if !ok && state.WebhooksMerchant.ID.ValueString() != "" {
+	data := r.client.Management().WebhooksMerchantLevelApi.GetWebhookInput(r.client.GetConfig().MerchantAccount, state.WebhooksMerchant.ID.ValueString())
+	webhookMerchantGetRequest, httpRes, err := r.client.Management().WebhooksMerchantLevelApi.GetWebhook(ctx, data)
+	if httpRes != nil && httpRes.StatusCode == http.StatusUnprocessableEntity { // Adyen responds with 422 Unprocessable Entity if the webhook does not exist.
 		resp.State.RemoveResource(ctx)
 		return
 	}
-
-	includeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookMerchantGetRequest.AdditionalSettings.IncludeEventCodes)
-	excludeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookMerchantGetRequest.AdditionalSettings.ExcludeEventCodes)
-	var properties map[string]attr.Value
-	if webhookMerchantGetRequest.AdditionalSettings.Properties != nil {
-		properties = mapWebhooksAdditionalSettingsProperties(*webhookMerchantGetRequest.AdditionalSettings.Properties)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Webhooks Merchant",
+			"Could not read merchant webhook "+state.WebhooksMerchant.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
 	}
 
+	// Adyen never returns the password, so keep the one from the prior state.
+	password := state.WebhooksMerchant.Password
 	state = webhooksMerchantResourceModel{
-		webhooksMerchantModel{
-			ID:                              types.StringPointerValue(webhookMerchantGetRequest.Id),
-			Type:                            types.StringValue(webhookMerchantGetRequest.Type),
-			URL:                             types.StringValue(webhookMerchantGetRequest.Url),
-			Username:                        types.StringPointerValue(webhookMerchantGetRequest.Username),
-			HasPassword:                     types.BoolPointerValue(webhookMerchantGetRequest.HasPassword),
-			Active:                          types.BoolValue(webhookMerchantGetRequest.Active),
-			HasError:                        types.BoolPointerValue(webhookMerchantGetRequest.HasError),
-			EncryptionProtocol:              types.StringPointerValue(webhookMerchantGetRequest.EncryptionProtocol),
-			CommunicationFormat:             types.StringValue(webhookMerchantGetRequest.CommunicationFormat),
-			AcceptsExpiredCertificate:       types.BoolPointerValue(webhookMerchantGetRequest.AcceptsExpiredCertificate),
-			AcceptsSelfSignedCertificate:    types.BoolPointerValue(webhookMerchantGetRequest.AcceptsSelfSignedCertificate),
-			AcceptsUntrustedRootCertificate: types.BoolPointerValue(webhookMerchantGetRequest.AcceptsUntrustedRootCertificate),
-			PopulateSoapActionHeader:        types.BoolPointerValue(webhookMerchantGetRequest.PopulateSoapActionHeader),
-			CertificateAlias:                types.StringPointerValue(webhookMerchantGetRequest.CertificateAlias),
-			Links: types.ObjectValueMust(linksAttributeMapMerchant, mapWebhooksLinksMerchant(
-				webhookMerchantGetRequest.Links.Self.Href,
-				webhookMerchantGetRequest.Links.GenerateHmac.Href,
-				webhookMerchantGetRequest.Links.Merchant.Href,
-				webhookMerchantGetRequest.Links.TestWebhook.Href),
-			),
-			AdditionalSettings: types.ObjectValueMust(additionalSettingsAttributeMap, map[string]attr.Value{
-				"include_event_codes": types.ListValueMust(types.StringType, includeEventCodes),
-				"exclude_event_codes": types.ListValueMust(types.StringType, excludeEventCodes),
-				"properties":          types.MapValueMust(types.BoolType, properties),
-			}),
-		},
+		mapWebhooksMerchantModel(webhookMerchantGetRequest),
 	}
+	state.WebhooksMerchant.Password = password
 
 	tflog.Debug(ctx, "Reading merchant webhook...")
 
@@ -412,8 +459,16 @@ func (r *webhookMerchantResource) Update(ctx context.Context, req resource.Updat
 		PopulateSoapActionHeader:        plan.WebhooksMerchant.PopulateSoapActionHeader.ValueBoolPointer(),
 		Url:                             plan.WebhooksMerchant.URL.ValueStringPointer(),
 		Username:                        plan.WebhooksMerchant.Username.ValueStringPointer(),
+		EncryptionProtocol:              knownStringPointer(plan.WebhooksMerchant.EncryptionProtocol),
 	}
 
+	additionalSettings, diags := mapWebhooksAdditionalSettingsRequest(ctx, plan.WebhooksMerchant.AdditionalSettings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateMerchantWebhookRequest.AdditionalSettings = additionalSettings
+
 	// Create a new webhook
 	webhookMerchantUpdateRequest := r.client.
 		Management().
@@ -432,46 +487,16 @@ func (r *webhookMerchantResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	includeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookMerchantUpdateResponse.AdditionalSettings.IncludeEventCodes)
-	excludeEventCodes := mapWebhooksAdditionalSettingsEventCodes(webhookMerchantUpdateResponse.AdditionalSettings.ExcludeEventCodes)
-	var properties map[string]attr.Value
-	if webhookMerchantUpdateResponse.AdditionalSettings.Properties != nil {
-		properties = mapWebhooksAdditionalSettingsProperties(*webhookMerchantUpdateResponse.AdditionalSettings.Properties)
-	}
-
 	// Map response body to schema and populate Computed attribute values
-	plan.WebhooksMerchant = webhooksMerchantModel{
-		ID:                              types.StringPointerValue(webhookMerchantUpdateResponse.Id),
-		Type:                            types.StringValue(webhookMerchantUpdateResponse.Type),
-		URL:                             types.StringValue(webhookMerchantUpdateResponse.Url),
-		Username:                        types.StringPointerValue(webhookMerchantUpdateResponse.Username),
-		HasPassword:                     types.BoolPointerValue(webhookMerchantUpdateResponse.HasPassword),
-		Active:                          types.BoolValue(webhookMerchantUpdateResponse.Active),
-		HasError:                        types.BoolPointerValue(webhookMerchantUpdateResponse.HasError),
-		EncryptionProtocol:              types.StringPointerValue(webhookMerchantUpdateResponse.EncryptionProtocol),
-		CommunicationFormat:             types.StringValue(webhookMerchantUpdateResponse.CommunicationFormat),
-		AcceptsExpiredCertificate:       types.BoolPointerValue(webhookMerchantUpdateResponse.AcceptsExpiredCertificate),
-		AcceptsSelfSignedCertificate:    types.BoolPointerValue(webhookMerchantUpdateResponse.AcceptsSelfSignedCertificate),
-		AcceptsUntrustedRootCertificate: types.BoolPointerValue(webhookMerchantUpdateResponse.AcceptsUntrustedRootCertificate),
-		PopulateSoapActionHeader:        types.BoolPointerValue(webhookMerchantUpdateResponse.PopulateSoapActionHeader),
-		CertificateAlias:                types.StringPointerValue(webhookMerchantUpdateResponse.CertificateAlias),
-		Password:                        types.StringPointerValue(updateMerchantWebhookRequest.Password), //FIXME
-		Links: types.ObjectValueMust(linksAttributeMapMerchant, mapWebhooksLinksMerchant(
-			webhookMerchantUpdateResponse.Links.Self.Href,
-			webhookMerchantUpdateResponse.Links.GenerateHmac.Href,
-			webhookMerchantUpdateResponse.Links.Merchant.Href,
-			webhookMerchantUpdateResponse.Links.TestWebhook.Href),
-		),
-		AdditionalSettings: types.ObjectValueMust(additionalSettingsAttributeMap, map[string]attr.Value{
-			"include_event_codes": types.ListValueMust(types.StringType, includeEventCodes),
-			"exclude_event_codes": types.ListValueMust(types.StringType, excludeEventCodes),
-			"properties":          types.MapValueMust(types.BoolType, properties),
-		}),
-	}
+	plan.WebhooksMerchant = mapWebhooksMerchantModel(webhookMerchantUpdateResponse)
+	plan.WebhooksMerchant.Password = types.StringPointerValue(updateMerchantWebhookRequest.Password) //FIXME
 
 	// Set state with the fully populated webhookCreateRequest
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	configuredAdditionalSettings, diags := configuredWebhookAdditionalSettingsPrivateState(ctx, req.Config, path.Root("webhooks_merchant"))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, webhookAdditionalSettingsConfiguredKey, configuredAdditionalSettings)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -497,7 +522,26 @@ func (r *webhookMerchantResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+// ImportState imports an existing webhook using an identifier of the form "<merchant_account>/<webhook_id>".
 func (r *webhookMerchantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	merchantAccount, webhookID, err := splitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import identifier of the form '<merchant_account>/<webhook_id>': "+err.Error(),
+		)
+		return
+	}
+
+	// The merchant webhook resource is bound to the merchant account of the provider.
+	if merchantAccount != r.client.GetConfig().MerchantAccount {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Merchant account '%s' does not match the merchant account configured for the provider.", merchantAccount),
+		)
+		return
+	}
+
+	// Retrieve import ID and save to the nested id attribute, Read fills in the remaining state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("webhooks_merchant").AtName("id"), webhookID)...)
 }
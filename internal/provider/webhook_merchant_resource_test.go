@@ -3,8 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"regexp"
 	"testing"
 )
 
@@ -34,6 +38,7 @@ func testAccCheckAdyenWebhookMerchantDestroy(tfstate *terraform.State) error {
 
 func TestAccWebhookMerchantResource(t *testing.T) {
 	resourceName := "adyen_webhooks_merchant.test"
+	var webhookID string
 
 	resource.ParallelTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -43,8 +48,7 @@ func TestAccWebhookMerchantResource(t *testing.T) {
 		},
 		Steps: []resource.TestStep{
 			{
-				Config:             testProviderClientFromTmpl(t) + testConfigCreateMerchantWebhook(),
-				ExpectNonEmptyPlan: true, // Creating a tf resource will propose changes, that's why this value is set to 'true'. Can be approached differently by using `PlanOnly: true`.
+				Config: testProviderClientFromTmpl(t) + testConfigCreateMerchantWebhook(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "webhooks_merchant.type", "standard"),
 					resource.TestCheckResourceAttr(resourceName, "webhooks_merchant.url", "https://webhook.site/test-uuid"),
@@ -57,6 +61,99 @@ func TestAccWebhookMerchantResource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "webhooks_merchant.populate_soap_action_header", "false"),
 				),
 			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "webhooks_merchant.id",
+				ImportStateVerifyIgnore:              []string{"webhooks_merchant.password"}, // Adyen never returns the password of a webhook.
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+					return os.Getenv("ADYEN_API_MERCHANT_ACCOUNT") + "/" + rs.Primary.Attributes["webhooks_merchant.id"], nil
+				},
+			},
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigUpdateMerchantWebhookAdditionalSettings(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "webhooks_merchant.additional_settings.properties.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks_merchant.additional_settings.properties.captureDelayHours", "true"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks_merchant.additional_settings.properties.authorisedAmountValue", "false"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks_merchant.additional_settings.include_event_codes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks_merchant.additional_settings.include_event_codes.0", "AUTHORISATION"),
+					func(s *terraform.State) error {
+						webhookID = s.RootModule().Resources[resourceName].Primary.Attributes["webhooks_merchant.id"]
+						return nil
+					},
+				),
+			},
+			{
+				// Changing the additional settings outside of Terraform must show up as drift.
+				PreConfig: func() {
+					suite := new(AcceptanceSuite)
+					suite.SetupSuite()
+					client := suite.client
+
+					properties := map[string]bool{"captureDelayHours": false}
+					updateWebhookInput := client.Management().WebhooksMerchantLevelApi.
+						UpdateWebhookInput(client.GetConfig().MerchantAccount, webhookID).
+						UpdateMerchantWebhookRequest(management.UpdateMerchantWebhookRequest{
+							AdditionalSettings: &management.AdditionalSettings{Properties: &properties},
+						})
+					if _, _, err := client.Management().WebhooksMerchantLevelApi.UpdateWebhook(context.Background(), updateWebhookInput); err != nil {
+						t.Fatalf("could not update merchant webhook %s: %s", webhookID, err)
+					}
+				},
+				Config:             testProviderClientFromTmpl(t) + testConfigUpdateMerchantWebhookAdditionalSettings(),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Removing the additional settings from the configuration clears them.
+				Config: testProviderClientFromTmpl(t) + testConfigCreateMerchantWebhook(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "webhooks_merchant.additional_settings.properties.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "webhooks_merchant.additional_settings.include_event_codes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWebhookMerchantResourceValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testProviderClientFromTmpl(t) + testConfigValidateMerchantWebhook("standard", "json", "TLSv1.2", true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`populate_soap_action_header only applies to the soap communication format`),
+			},
+			{
+				Config:      testProviderClientFromTmpl(t) + testConfigValidateMerchantWebhook("unknown-notification", "json", "TLSv1.2", false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			{
+				Config:      testProviderClientFromTmpl(t) + testConfigValidateMerchantWebhook("standard", "xml", "TLSv1.2", false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			{
+				Config:      testProviderClientFromTmpl(t) + testConfigValidateMerchantWebhook("standard", "json", "SSLv3", false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
 		},
 	})
 }
@@ -79,3 +176,49 @@ func testConfigCreateMerchantWebhook() string {
 	}
 `
 }
+
+func testConfigUpdateMerchantWebhookAdditionalSettings() string {
+	return `
+	resource "adyen_webhooks_merchant" "test" {
+		webhooks_merchant = {
+			type                               = "standard"
+			url                                = "https://webhook.site/test-uuid"
+			username                           = "YOUR_TEST_USER_1"
+			password                           = "YOUR_TEST_PASSWORD_FROM_TERRAFORM_1"
+			active                             = false
+			communication_format               = "json"
+			accepts_expired_certificate        = false
+			accepts_self_signed_certificate    = true
+			accepts_untrusted_root_certificate = true
+			populate_soap_action_header        = false
+			additional_settings = {
+				include_event_codes = ["AUTHORISATION"]
+				properties = {
+					captureDelayHours     = true
+					authorisedAmountValue = false
+				}
+			}
+		}
+	}
+`
+}
+
+func testConfigValidateMerchantWebhook(webhookType string, communicationFormat string, encryptionProtocol string, populateSoapActionHeader bool) string {
+	return fmt.Sprintf(`
+	resource "adyen_webhooks_merchant" "validate" {
+		webhooks_merchant = {
+			type                               = "%s"
+			url                                = "https://webhook.site/test-uuid"
+			username                           = "YOUR_TEST_USER_1"
+			password                           = "YOUR_TEST_PASSWORD_FROM_TERRAFORM_1"
+			active                             = true
+			communication_format               = "%s"
+			encryption_protocol                = "%s"
+			accepts_expired_certificate        = false
+			accepts_self_signed_certificate    = true
+			accepts_untrusted_root_certificate = true
+			populate_soap_action_header        = %t
+		}
+	}
+`, webhookType, communicationFormat, encryptionProtocol, populateSoapActionHeader)
+}
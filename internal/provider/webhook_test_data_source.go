@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/adyen/adyen-go-api-library/v9/src/adyen"
+	"github.com/adyen/adyen-go-api-library/v9/src/management"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &webhookTestDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookTestDataSource{}
+)
+
+// webhookTestDataSource is the data source implementation.
+type webhookTestDataSource struct {
+	client *adyen.APIClient
+}
+
+// NewWebhookTestDataSource is a helper function to simplify the provider implementation.
+func NewWebhookTestDataSource() datasource.DataSource {
+	return &webhookTestDataSource{}
+}
+
+// webhookTestDataSourceModel maps the "webhook_test" schema data for a data source.
+type webhookTestDataSourceModel struct {
+	WebhookID      types.String             `tfsdk:"webhook_id"`
+	CompanyAccount types.String             `tfsdk:"company_account"`
+	Types          []types.String           `tfsdk:"types"`
+	MerchantIDs    []types.String           `tfsdk:"merchant_ids"`
+	Success        types.Bool               `tfsdk:"success"`
+	Results        []webhookTestResultModel `tfsdk:"results"`
+}
+
+// webhookTestResultModel maps the result of a single test webhook.
+type webhookTestResultModel struct {
+	MerchantID   types.String `tfsdk:"merchant_id"`
+	Status       types.String `tfsdk:"status"`
+	Output       types.String `tfsdk:"output"`
+	RequestSent  types.String `tfsdk:"request_sent"`
+	ResponseCode types.String `tfsdk:"response_code"`
+	ResponseTime types.String `tfsdk:"response_time"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webhookTestDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adyen.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adyen.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *webhookTestDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_test"
+}
+
+// Schema defines the schema for the data source.
+func (d *webhookTestDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends test notifications to a merchant or company webhook and returns the result of each test. " +
+			"The test is sent every time the data source is read, so the success attribute can be used in a check block or postcondition to verify a webhook can be reached.\n\n" +
+			"To make this request, your API credential must have the following roles:\n\nManagement API—Webhooks read and write",
+		Attributes: map[string]schema.Attribute{
+			"webhook_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the webhook to test.",
+			},
+			"company_account": schema.StringAttribute{
+				Optional:    true,
+				Description: "The company account of a company webhook. If not set, the webhook belongs to the merchant account of the provider.",
+			},
+			"types": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "List of event codes for which to send test notifications, for example AUTHORISATION or REPORT_AVAILABLE. The supported values depend on the type of the webhook.",
+			},
+			"merchant_ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only for company webhooks. List of at most 20 merchant accounts for which to send test notifications. If not set, test notifications are sent for all merchant accounts the webhook is configured for.",
+			},
+			"success": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates if test notifications were sent and all of them were accepted by the webhook.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The result of each test notification that was sent.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"merchant_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the merchant account that the notification is about.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the test request: success if the webhook responded with 200 and [accepted], failed in all other cases.",
+						},
+						"output": schema.StringAttribute{
+							Computed:    true,
+							Description: "The response the webhook returned for the test notification.",
+						},
+						"request_sent": schema.StringAttribute{
+							Computed:    true,
+							Description: "The body of the notification that was sent to the webhook.",
+						},
+						"response_code": schema.StringAttribute{
+							Computed:    true,
+							Description: "The HTTP response code the webhook returned for the test notification.",
+						},
+						"response_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time between sending the test notification and receiving the response, for example 304 ms.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read sends the test notifications and sets the results in the Terraform state.
+func (d *webhookTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state webhookTestDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Testing webhook "+state.WebhookID.ValueString())
+
+	eventTypes := make([]string, 0, len(state.Types))
+	for _, eventType := range state.Types {
+		eventTypes = append(eventTypes, eventType.ValueString())
+	}
+
+	var testWebhookResponse management.TestWebhookResponse
+	var err error
+	if state.CompanyAccount.ValueString() != "" {
+		merchantIDs := make([]string, 0, len(state.MerchantIDs))
+		for _, merchantID := range state.MerchantIDs {
+			merchantIDs = append(merchantIDs, merchantID.ValueString())
+		}
+		testWebhookInput := d.client.Management().WebhooksCompanyLevelApi.
+			TestWebhookInput(state.CompanyAccount.ValueString(), state.WebhookID.ValueString()).
+			TestCompanyWebhookRequest(management.TestCompanyWebhookRequest{
+				Types:       eventTypes,
+				MerchantIds: merchantIDs,
+			})
+		testWebhookResponse, _, err = d.client.Management().WebhooksCompanyLevelApi.TestWebhook(ctx, testWebhookInput)
+	} else {
+		if len(state.MerchantIDs) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("merchant_ids"),
+				"Invalid Webhook Test Configuration",
+				"merchant_ids can only be set when testing a company webhook. Set company_account or remove merchant_ids.",
+			)
+			return
+		}
+		testWebhookInput := d.client.Management().WebhooksMerchantLevelApi.
+			TestWebhookInput(d.client.GetConfig().MerchantAccount, state.WebhookID.ValueString()).
+			TestWebhookRequest(management.TestWebhookRequest{
+				Types: eventTypes,
+			})
+		testWebhookResponse, _, err = d.client.Management().WebhooksMerchantLevelApi.TestWebhook(ctx, testWebhookInput)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Testing Webhook",
+			"Could not test webhook "+state.WebhookID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Without any results, no test notification was accepted.
+	success := len(testWebhookResponse.GetData()) > 0
+	state.Results = []webhookTestResultModel{}
+	for _, output := range testWebhookResponse.GetData() {
+		if output.Status != "success" {
+			success = false
+		}
+		state.Results = append(state.Results, webhookTestResultModel{
+			MerchantID:   types.StringPointerValue(output.MerchantId),
+			Status:       types.StringValue(output.Status),
+			Output:       types.StringPointerValue(output.Output),
+			RequestSent:  types.StringPointerValue(output.RequestSent),
+			ResponseCode: types.StringPointerValue(output.ResponseCode),
+			ResponseTime: types.StringPointerValue(output.ResponseTime),
+		})
+	}
+
+	state.Success = types.BoolValue(success)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccWebhookTestDataSource(t *testing.T) {
+	merchantDataSourceName := "data.adyen_webhook_test.merchant"
+	companyDataSourceName := "data.adyen_webhook_test.company"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderClientFromTmpl(t) + testConfigCreateMerchantWebhook() + testConfigCreateCompanyWebhook() + testConfigWebhookTestDataSources(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(merchantDataSourceName, "success", "true"),
+					resource.TestCheckResourceAttr(merchantDataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(merchantDataSourceName, "results.0.status", "success"),
+					resource.TestCheckResourceAttr(merchantDataSourceName, "results.0.output", "[accepted]"),
+					resource.TestCheckResourceAttr(merchantDataSourceName, "results.0.response_code", "200"),
+					resource.TestCheckResourceAttrSet(merchantDataSourceName, "results.0.request_sent"),
+					resource.TestCheckResourceAttr(companyDataSourceName, "success", "true"),
+					resource.TestCheckResourceAttr(companyDataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(companyDataSourceName, "results.0.merchant_id", "WeaveAccountECOM"),
+				),
+			},
+			{
+				// A test that sends no notifications is not a success.
+				SkipFunc: func() (bool, error) {
+					return testMockServer == nil, nil
+				},
+				Config: testProviderClientFromTmpl(t) + testConfigCreateMerchantWebhook() + `
+				data "adyen_webhook_test" "empty" {
+					webhook_id = adyen_webhooks_merchant.test.webhooks_merchant.id
+					types      = []
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.adyen_webhook_test.empty", "results.#", "0"),
+					resource.TestCheckResourceAttr("data.adyen_webhook_test.empty", "success", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testConfigWebhookTestDataSources() string {
+	return `
+	data "adyen_webhook_test" "merchant" {
+		webhook_id = adyen_webhooks_merchant.test.webhooks_merchant.id
+		types      = ["AUTHORISATION", "REPORT_AVAILABLE"]
+	}
+
+	data "adyen_webhook_test" "company" {
+		webhook_id      = adyen_webhooks_company.test.webhooks_company.id
+		company_account = adyen_webhooks_company.test.company_account
+		types           = ["AUTHORISATION"]
+		merchant_ids    = ["WeaveAccountECOM"]
+	}
+`
+}